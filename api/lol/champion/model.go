@@ -1,7 +1,29 @@
 package champion
 
+import "leago/ddragon"
+
 type Rotation struct {
 	MaxNewPlayerLevel            int   `json:"maxNewPlayerLevel"`
 	FreeChampionIdsForNewPlayers []int `json:"freeChampionIdsForNewPlayers"`
 	FreeChampionIds              []int `json:"freeChampionIds"`
 }
+
+// Champions returns the free champions of the rotation, skipping the ones the resolver doesn't know.
+func (r Rotation) Champions(resolver *ddragon.ChampionResolver) []ddragon.Champion {
+	return resolveChampions(r.FreeChampionIds, resolver)
+}
+
+// ChampionsForNewPlayers returns the free champions for new players, skipping the ones the resolver doesn't know.
+func (r Rotation) ChampionsForNewPlayers(resolver *ddragon.ChampionResolver) []ddragon.Champion {
+	return resolveChampions(r.FreeChampionIdsForNewPlayers, resolver)
+}
+
+func resolveChampions(ids []int, resolver *ddragon.ChampionResolver) []ddragon.Champion {
+	out := make([]ddragon.Champion, 0, len(ids))
+	for _, id := range ids {
+		if champion, ok := resolver.ByKey(id); ok {
+			out = append(out, champion)
+		}
+	}
+	return out
+}
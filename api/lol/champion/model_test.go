@@ -0,0 +1,28 @@
+package champion
+
+import (
+	"leago/ddragon"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRotationChampions(t *testing.T) {
+	resolver := ddragon.EmbeddedChampionResolver()
+	rotation := Rotation{
+		FreeChampionIds:              []int{62, 266, 99999},
+		FreeChampionIdsForNewPlayers: []int{1},
+	}
+
+	names := func(champions []ddragon.Champion) []string {
+		out := make([]string, len(champions))
+		for i, c := range champions {
+			out[i] = c.Name
+		}
+		return out
+	}
+
+	assert.Equal(t, []string{"Wukong", "Aatrox"}, names(rotation.Champions(resolver)))
+	assert.Equal(t, []string{"Annie"}, names(rotation.ChampionsForNewPlayers(resolver)))
+	assert.Empty(t, Rotation{}.Champions(resolver))
+}
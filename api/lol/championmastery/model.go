@@ -1,5 +1,7 @@
 package championmastery
 
+import "leago/ddragon"
+
 type (
 	MasteryList []Mastery
 
@@ -34,3 +36,8 @@ type (
 
 	MasteryScore int
 )
+
+// Champion returns the champion of the mastery, false if the resolver doesn't know it.
+func (m Mastery) Champion(resolver *ddragon.ChampionResolver) (ddragon.Champion, bool) {
+	return resolver.ByKey(int(m.ChampionID))
+}
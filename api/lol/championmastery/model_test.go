@@ -0,0 +1,22 @@
+package championmastery
+
+import (
+	"leago/ddragon"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMasteryChampion(t *testing.T) {
+	resolver := ddragon.EmbeddedChampionResolver()
+
+	champion, ok := Mastery{ChampionID: 62}.Champion(resolver)
+	require.True(t, ok)
+	assert.Equal(t, "MonkeyKing", champion.ID)
+	assert.Equal(t, "Wukong", champion.Name)
+	assert.Equal(t, "https://ddragon.leagueoflegends.com/cdn/15.14.1/img/champion/MonkeyKing.png", resolver.IconURL(champion))
+
+	_, ok = Mastery{ChampionID: 99999}.Champion(resolver)
+	assert.False(t, ok)
+}
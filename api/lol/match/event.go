@@ -0,0 +1,300 @@
+package match
+
+import (
+	"encoding/json"
+	"slices"
+)
+
+type (
+	// Event is a single timeline event, decoded into a pointer to its concrete type based on the type field.
+	// Use a type switch, like *ChampionKill or *ItemPurchased, to access the event specific fields.
+	// Types without a concrete type are decoded as *UnknownEvent, keeping the raw JSON.
+	Event interface {
+		EventType() EventType
+		EventTimestamp() int64
+	}
+
+	// Events decodes the timeline events into their concrete types.
+	Events []Event
+
+	EventType string
+
+	// EventBase are the fields shared by every event.
+	EventBase struct {
+		Timestamp int64     `json:"timestamp"`
+		Type      EventType `json:"type"`
+	}
+
+	ChampionKill struct {
+		EventBase
+		AssistingParticipantIDs []int            `json:"assistingParticipantIds"`
+		Bounty                  int              `json:"bounty"`
+		KillStreakLength        int              `json:"killStreakLength"`
+		KillerID                int              `json:"killerId"`
+		Position                Position         `json:"position"`
+		ShutdownBounty          int              `json:"shutdownBounty"`
+		VictimDamageDealt       []DamageInstance `json:"victimDamageDealt"`
+		VictimDamageReceived    []DamageInstance `json:"victimDamageReceived"`
+		VictimID                int              `json:"victimId"`
+	}
+
+	// DamageInstance is a damage source sent on the champion kill recap.
+	DamageInstance struct {
+		Basic          bool   `json:"basic"`
+		MagicDamage    int    `json:"magicDamage"`
+		Name           string `json:"name"`
+		ParticipantID  int    `json:"participantId"`
+		PhysicalDamage int    `json:"physicalDamage"`
+		SpellName      string `json:"spellName"`
+		SpellSlot      int    `json:"spellSlot"`
+		TrueDamage     int    `json:"trueDamage"`
+		Type           string `json:"type"`
+	}
+
+	// ChampionSpecialKill is sent for first bloods, multi kills and aces.
+	ChampionSpecialKill struct {
+		EventBase
+		KillType        string   `json:"killType"`
+		KillerID        int      `json:"killerId"`
+		MultiKillLength int      `json:"multiKillLength"`
+		Position        Position `json:"position"`
+	}
+
+	ChampionTransform struct {
+		EventBase
+		ParticipantID int    `json:"participantId"`
+		TransformType string `json:"transformType"`
+	}
+
+	ItemPurchased struct {
+		EventBase
+		ItemID        int `json:"itemId"`
+		ParticipantID int `json:"participantId"`
+	}
+
+	ItemSold struct {
+		EventBase
+		ItemID        int `json:"itemId"`
+		ParticipantID int `json:"participantId"`
+	}
+
+	ItemDestroyed struct {
+		EventBase
+		ItemID        int `json:"itemId"`
+		ParticipantID int `json:"participantId"`
+	}
+
+	// ItemUndo is sent when the player undoes a purchase or sale, the item IDs are 0 when there's no item.
+	ItemUndo struct {
+		EventBase
+		AfterID       int `json:"afterId"`
+		BeforeID      int `json:"beforeId"`
+		GoldGain      int `json:"goldGain"`
+		ParticipantID int `json:"participantId"`
+	}
+
+	BuildingKill struct {
+		EventBase
+		AssistingParticipantIDs []int    `json:"assistingParticipantIds"`
+		Bounty                  int      `json:"bounty"`
+		BuildingType            string   `json:"buildingType"`
+		KillerID                int      `json:"killerId"`
+		LaneType                string   `json:"laneType"`
+		Position                Position `json:"position"`
+		TeamID                  int      `json:"teamId"`
+		TowerType               string   `json:"towerType"`
+	}
+
+	TurretPlateDestroyed struct {
+		EventBase
+		KillerID int      `json:"killerId"`
+		LaneType string   `json:"laneType"`
+		Position Position `json:"position"`
+		TeamID   int      `json:"teamId"`
+	}
+
+	EliteMonsterKill struct {
+		EventBase
+		AssistingParticipantIDs []int    `json:"assistingParticipantIds"`
+		Bounty                  int      `json:"bounty"`
+		KillerID                int      `json:"killerId"`
+		KillerTeamID            int      `json:"killerTeamId"`
+		MonsterSubType          string   `json:"monsterSubType"`
+		MonsterType             string   `json:"monsterType"`
+		Position                Position `json:"position"`
+	}
+
+	DragonSoulGiven struct {
+		EventBase
+		Name   string `json:"name"`
+		TeamID int    `json:"teamId"`
+	}
+
+	WardPlaced struct {
+		EventBase
+		CreatorID int    `json:"creatorId"`
+		WardType  string `json:"wardType"`
+	}
+
+	WardKill struct {
+		EventBase
+		KillerID int    `json:"killerId"`
+		WardType string `json:"wardType"`
+	}
+
+	SkillLevelUp struct {
+		EventBase
+		LevelUpType   string `json:"levelUpType"`
+		ParticipantID int    `json:"participantId"`
+		SkillSlot     int    `json:"skillSlot"`
+	}
+
+	LevelUp struct {
+		EventBase
+		Level         int `json:"level"`
+		ParticipantID int `json:"participantId"`
+	}
+
+	ObjectiveBountyPrestart struct {
+		EventBase
+		ActualStartTime int64 `json:"actualStartTime"`
+		TeamID          int   `json:"teamId"`
+	}
+
+	ObjectiveBountyFinish struct {
+		EventBase
+		TeamID int `json:"teamId"`
+	}
+
+	FeatUpdate struct {
+		EventBase
+		FeatType  int `json:"featType"`
+		FeatValue int `json:"featValue"`
+		TeamID    int `json:"teamId"`
+	}
+
+	PauseEnd struct {
+		EventBase
+		RealTimestamp int64 `json:"realTimestamp"`
+	}
+
+	GameEnd struct {
+		EventBase
+		GameID        int64 `json:"gameId"`
+		RealTimestamp int64 `json:"realTimestamp"`
+		WinningTeam   int   `json:"winningTeam"`
+	}
+
+	// UnknownEvent is an event type not known by leago, kept as the raw JSON sent by Riot.
+	UnknownEvent struct {
+		EventBase
+		Raw json.RawMessage
+	}
+)
+
+const (
+	EventTypeChampionKill            EventType = "CHAMPION_KILL"
+	EventTypeChampionSpecialKill     EventType = "CHAMPION_SPECIAL_KILL"
+	EventTypeChampionTransform       EventType = "CHAMPION_TRANSFORM"
+	EventTypeItemPurchased           EventType = "ITEM_PURCHASED"
+	EventTypeItemSold                EventType = "ITEM_SOLD"
+	EventTypeItemDestroyed           EventType = "ITEM_DESTROYED"
+	EventTypeItemUndo                EventType = "ITEM_UNDO"
+	EventTypeBuildingKill            EventType = "BUILDING_KILL"
+	EventTypeTurretPlateDestroyed    EventType = "TURRET_PLATE_DESTROYED"
+	EventTypeEliteMonsterKill        EventType = "ELITE_MONSTER_KILL"
+	EventTypeDragonSoulGiven         EventType = "DRAGON_SOUL_GIVEN"
+	EventTypeWardPlaced              EventType = "WARD_PLACED"
+	EventTypeWardKill                EventType = "WARD_KILL"
+	EventTypeSkillLevelUp            EventType = "SKILL_LEVEL_UP"
+	EventTypeLevelUp                 EventType = "LEVEL_UP"
+	EventTypeObjectiveBountyPrestart EventType = "OBJECTIVE_BOUNTY_PRESTART"
+	EventTypeObjectiveBountyFinish   EventType = "OBJECTIVE_BOUNTY_FINISH"
+	EventTypeFeatUpdate              EventType = "FEAT_UPDATE"
+	EventTypePauseEnd                EventType = "PAUSE_END"
+	EventTypeGameEnd                 EventType = "GAME_END"
+)
+
+// eventTypes builds an empty concrete event for each known type.
+var eventTypes = map[EventType]func() Event{
+	EventTypeChampionKill:            func() Event { return &ChampionKill{} },
+	EventTypeChampionSpecialKill:     func() Event { return &ChampionSpecialKill{} },
+	EventTypeChampionTransform:       func() Event { return &ChampionTransform{} },
+	EventTypeItemPurchased:           func() Event { return &ItemPurchased{} },
+	EventTypeItemSold:                func() Event { return &ItemSold{} },
+	EventTypeItemDestroyed:           func() Event { return &ItemDestroyed{} },
+	EventTypeItemUndo:                func() Event { return &ItemUndo{} },
+	EventTypeBuildingKill:            func() Event { return &BuildingKill{} },
+	EventTypeTurretPlateDestroyed:    func() Event { return &TurretPlateDestroyed{} },
+	EventTypeEliteMonsterKill:        func() Event { return &EliteMonsterKill{} },
+	EventTypeDragonSoulGiven:         func() Event { return &DragonSoulGiven{} },
+	EventTypeWardPlaced:              func() Event { return &WardPlaced{} },
+	EventTypeWardKill:                func() Event { return &WardKill{} },
+	EventTypeSkillLevelUp:            func() Event { return &SkillLevelUp{} },
+	EventTypeLevelUp:                 func() Event { return &LevelUp{} },
+	EventTypeObjectiveBountyPrestart: func() Event { return &ObjectiveBountyPrestart{} },
+	EventTypeObjectiveBountyFinish:   func() Event { return &ObjectiveBountyFinish{} },
+	EventTypeFeatUpdate:              func() Event { return &FeatUpdate{} },
+	EventTypePauseEnd:                func() Event { return &PauseEnd{} },
+	EventTypeGameEnd:                 func() Event { return &GameEnd{} },
+}
+
+func (e EventBase) EventType() EventType {
+	return e.Type
+}
+
+func (e EventBase) EventTimestamp() int64 {
+	return e.Timestamp
+}
+
+// UnmarshalEvent decodes a single timeline event into its concrete type.
+func UnmarshalEvent(data []byte) (Event, error) {
+	var base EventBase
+	if err := json.Unmarshal(data, &base); err != nil {
+		return nil, err
+	}
+
+	newEvent, ok := eventTypes[base.Type]
+	if !ok {
+		return &UnknownEvent{EventBase: base, Raw: slices.Clone(data)}, nil
+	}
+
+	event := newEvent()
+	if err := json.Unmarshal(data, event); err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
+func (e *Events) UnmarshalJSON(data []byte) error {
+	var raws []json.RawMessage
+	if err := json.Unmarshal(data, &raws); err != nil {
+		return err
+	}
+
+	if raws == nil {
+		*e = nil
+		return nil
+	}
+
+	events := make(Events, len(raws))
+	for i, raw := range raws {
+		event, err := UnmarshalEvent(raw)
+		if err != nil {
+			return err
+		}
+		events[i] = event
+	}
+
+	*e = events
+	return nil
+}
+
+// MarshalJSON returns the raw JSON sent by Riot.
+func (e UnknownEvent) MarshalJSON() ([]byte, error) {
+	if e.Raw == nil {
+		return json.Marshal(e.EventBase)
+	}
+	return e.Raw, nil
+}
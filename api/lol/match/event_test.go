@@ -0,0 +1,128 @@
+package match
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnmarshalEvent(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		expected Event
+		wantErr  bool
+	}{
+		{
+			name: "champion kill",
+			data: `{
+				"type":"CHAMPION_KILL",
+				"timestamp":605000,
+				"assistingParticipantIds":[2,3],
+				"bounty":300,
+				"killStreakLength":1,
+				"killerId":1,
+				"position":{"x":7000,"y":7200},
+				"shutdownBounty":0,
+				"victimDamageReceived":[{"basic":false,"magicDamage":250,"name":"Ahri","participantId":1,"spellName":"ahriorbofdeception","spellSlot":0,"type":"OTHER"}],
+				"victimId":6
+			}`,
+			expected: &ChampionKill{
+				EventBase:               EventBase{Timestamp: 605000, Type: EventTypeChampionKill},
+				AssistingParticipantIDs: []int{2, 3},
+				Bounty:                  300,
+				KillStreakLength:        1,
+				KillerID:                1,
+				Position:                Position{X: 7000, Y: 7200},
+				VictimDamageReceived: []DamageInstance{
+					{MagicDamage: 250, Name: "Ahri", ParticipantID: 1, SpellName: "ahriorbofdeception", Type: "OTHER"},
+				},
+				VictimID: 6,
+			},
+		},
+		{
+			name: "item undo",
+			data: `{"type":"ITEM_UNDO","timestamp":1000,"afterId":0,"beforeId":1055,"goldGain":450,"participantId":4}`,
+			expected: &ItemUndo{
+				EventBase:     EventBase{Timestamp: 1000, Type: EventTypeItemUndo},
+				BeforeID:      1055,
+				GoldGain:      450,
+				ParticipantID: 4,
+			},
+		},
+		{
+			name: "game end",
+			data: `{"type":"GAME_END","timestamp":1800000,"gameId":3000000000,"realTimestamp":1700001900000,"winningTeam":100}`,
+			expected: &GameEnd{
+				EventBase:     EventBase{Timestamp: 1800000, Type: EventTypeGameEnd},
+				GameID:        3000000000,
+				RealTimestamp: 1700001900000,
+				WinningTeam:   100,
+			},
+		},
+		{
+			name: "unknown type",
+			data: `{"type":"NEW_EVENT","timestamp":10,"someField":true}`,
+			expected: &UnknownEvent{
+				EventBase: EventBase{Timestamp: 10, Type: "NEW_EVENT"},
+				Raw:       json.RawMessage(`{"type":"NEW_EVENT","timestamp":10,"someField":true}`),
+			},
+		},
+		{
+			name:    "mismatched field type",
+			data:    `{"type":"LEVEL_UP","timestamp":10,"level":"two"}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid json",
+			data:    `{"type":`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event, err := UnmarshalEvent([]byte(tt.data))
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				return
+			}
+
+			require.Nil(t, err)
+			assert.Equal(t, tt.expected, event)
+		})
+	}
+}
+
+func TestEventsUnmarshalJSON(t *testing.T) {
+	var frame Frame
+	err := json.Unmarshal([]byte(`{
+		"events":[
+			{"type":"ITEM_PURCHASED","timestamp":1500,"itemId":1055,"participantId":1},
+			{"type":"SKILL_LEVEL_UP","timestamp":2000,"levelUpType":"NORMAL","participantId":1,"skillSlot":1},
+			{"type":"UNRELEASED_EVENT","timestamp":2500}
+		],
+		"timestamp":60000
+	}`), &frame)
+	require.Nil(t, err)
+	require.Len(t, frame.Events, 3)
+
+	purchase, ok := frame.Events[0].(*ItemPurchased)
+	require.True(t, ok)
+	assert.Equal(t, 1055, purchase.ItemID)
+	assert.Equal(t, EventTypeItemPurchased, purchase.EventType())
+
+	skill, ok := frame.Events[1].(*SkillLevelUp)
+	require.True(t, ok)
+	assert.Equal(t, 1, skill.SkillSlot)
+
+	unknown, ok := frame.Events[2].(*UnknownEvent)
+	require.True(t, ok)
+	assert.Equal(t, int64(2500), unknown.EventTimestamp())
+
+	out, err := json.Marshal(unknown)
+	require.Nil(t, err)
+	assert.JSONEq(t, `{"type":"UNRELEASED_EVENT","timestamp":2500}`, string(out))
+}
@@ -0,0 +1,456 @@
+package match
+
+type (
+	Match struct {
+		Metadata Metadata `json:"metadata"`
+		Info     Info     `json:"info"`
+	}
+
+	Metadata struct {
+		DataVersion  string   `json:"dataVersion"`
+		MatchID      string   `json:"matchId"`
+		Participants []string `json:"participants"`
+	}
+
+	Info struct {
+		EndOfGameResult    string        `json:"endOfGameResult"`
+		GameCreation       int64         `json:"gameCreation"`
+		GameDuration       int64         `json:"gameDuration"`
+		GameEndTimestamp   int64         `json:"gameEndTimestamp"`
+		GameID             int64         `json:"gameId"`
+		GameMode           string        `json:"gameMode"`
+		GameName           string        `json:"gameName"`
+		GameStartTimestamp int64         `json:"gameStartTimestamp"`
+		GameType           string        `json:"gameType"`
+		GameVersion        string        `json:"gameVersion"`
+		MapID              int           `json:"mapId"`
+		Participants       []Participant `json:"participants"`
+		PlatformID         string        `json:"platformId"`
+		QueueID            int           `json:"queueId"`
+		Teams              []Team        `json:"teams"`
+		TournamentCode     string        `json:"tournamentCode"`
+	}
+
+	Participant struct {
+		AllInPings                     int         `json:"allInPings"`
+		AssistMePings                  int         `json:"assistMePings"`
+		Assists                        int         `json:"assists"`
+		BaronKills                     int         `json:"baronKills"`
+		BasicPings                     int         `json:"basicPings"`
+		BountyLevel                    int         `json:"bountyLevel"`
+		Challenges                     *Challenges `json:"challenges,omitempty"`
+		ChampExperience                int         `json:"champExperience"`
+		ChampLevel                     int         `json:"champLevel"`
+		ChampionID                     int         `json:"championId"`
+		ChampionName                   string      `json:"championName"`
+		ChampionTransform              int         `json:"championTransform"`
+		CommandPings                   int         `json:"commandPings"`
+		ConsumablesPurchased           int         `json:"consumablesPurchased"`
+		DamageDealtToBuildings         int         `json:"damageDealtToBuildings"`
+		DamageDealtToObjectives        int         `json:"damageDealtToObjectives"`
+		DamageDealtToTurrets           int         `json:"damageDealtToTurrets"`
+		DamageSelfMitigated            int         `json:"damageSelfMitigated"`
+		DangerPings                    int         `json:"dangerPings"`
+		Deaths                         int         `json:"deaths"`
+		DetectorWardsPlaced            int         `json:"detectorWardsPlaced"`
+		DoubleKills                    int         `json:"doubleKills"`
+		DragonKills                    int         `json:"dragonKills"`
+		EligibleForProgression         bool        `json:"eligibleForProgression"`
+		EnemyMissingPings              int         `json:"enemyMissingPings"`
+		EnemyVisionPings               int         `json:"enemyVisionPings"`
+		FirstBloodAssist               bool        `json:"firstBloodAssist"`
+		FirstBloodKill                 bool        `json:"firstBloodKill"`
+		FirstTowerAssist               bool        `json:"firstTowerAssist"`
+		FirstTowerKill                 bool        `json:"firstTowerKill"`
+		GameEndedInEarlySurrender      bool        `json:"gameEndedInEarlySurrender"`
+		GameEndedInSurrender           bool        `json:"gameEndedInSurrender"`
+		GetBackPings                   int         `json:"getBackPings"`
+		GoldEarned                     int         `json:"goldEarned"`
+		GoldSpent                      int         `json:"goldSpent"`
+		HoldPings                      int         `json:"holdPings"`
+		IndividualPosition             string      `json:"individualPosition"`
+		InhibitorKills                 int         `json:"inhibitorKills"`
+		InhibitorTakedowns             int         `json:"inhibitorTakedowns"`
+		InhibitorsLost                 int         `json:"inhibitorsLost"`
+		Item0                          int         `json:"item0"`
+		Item1                          int         `json:"item1"`
+		Item2                          int         `json:"item2"`
+		Item3                          int         `json:"item3"`
+		Item4                          int         `json:"item4"`
+		Item5                          int         `json:"item5"`
+		Item6                          int         `json:"item6"`
+		ItemsPurchased                 int         `json:"itemsPurchased"`
+		KillingSprees                  int         `json:"killingSprees"`
+		Kills                          int         `json:"kills"`
+		Lane                           string      `json:"lane"`
+		LargestCriticalStrike          int         `json:"largestCriticalStrike"`
+		LargestKillingSpree            int         `json:"largestKillingSpree"`
+		LargestMultiKill               int         `json:"largestMultiKill"`
+		LongestTimeSpentLiving         int         `json:"longestTimeSpentLiving"`
+		MagicDamageDealt               int         `json:"magicDamageDealt"`
+		MagicDamageDealtToChampions    int         `json:"magicDamageDealtToChampions"`
+		MagicDamageTaken               int         `json:"magicDamageTaken"`
+		Missions                       *Missions   `json:"missions,omitempty"`
+		NeedVisionPings                int         `json:"needVisionPings"`
+		NeutralMinionsKilled           int         `json:"neutralMinionsKilled"`
+		NexusKills                     int         `json:"nexusKills"`
+		NexusLost                      int         `json:"nexusLost"`
+		NexusTakedowns                 int         `json:"nexusTakedowns"`
+		ObjectivesStolen               int         `json:"objectivesStolen"`
+		ObjectivesStolenAssists        int         `json:"objectivesStolenAssists"`
+		OnMyWayPings                   int         `json:"onMyWayPings"`
+		ParticipantID                  int         `json:"participantId"`
+		PentaKills                     int         `json:"pentaKills"`
+		Perks                          Perks       `json:"perks"`
+		PhysicalDamageDealt            int         `json:"physicalDamageDealt"`
+		PhysicalDamageDealtToChampions int         `json:"physicalDamageDealtToChampions"`
+		PhysicalDamageTaken            int         `json:"physicalDamageTaken"`
+		Placement                      int         `json:"placement"`
+		PlayerAugment1                 int         `json:"playerAugment1"`
+		PlayerAugment2                 int         `json:"playerAugment2"`
+		PlayerAugment3                 int         `json:"playerAugment3"`
+		PlayerAugment4                 int         `json:"playerAugment4"`
+		PlayerAugment5                 int         `json:"playerAugment5"`
+		PlayerAugment6                 int         `json:"playerAugment6"`
+		PlayerSubteamID                int         `json:"playerSubteamId"`
+		ProfileIcon                    int         `json:"profileIcon"`
+		PushPings                      int         `json:"pushPings"`
+		PUUID                          string      `json:"puuid"`
+		QuadraKills                    int         `json:"quadraKills"`
+		RetreatPings                   int         `json:"retreatPings"`
+		RiotIDGameName                 string      `json:"riotIdGameName"`
+		RiotIDTagline                  string      `json:"riotIdTagline"`
+		Role                           string      `json:"role"`
+		SightWardsBoughtInGame         int         `json:"sightWardsBoughtInGame"`
+		Spell1Casts                    int         `json:"spell1Casts"`
+		Spell2Casts                    int         `json:"spell2Casts"`
+		Spell3Casts                    int         `json:"spell3Casts"`
+		Spell4Casts                    int         `json:"spell4Casts"`
+		SubteamPlacement               int         `json:"subteamPlacement"`
+		Summoner1Casts                 int         `json:"summoner1Casts"`
+		Summoner1ID                    int         `json:"summoner1Id"`
+		Summoner2Casts                 int         `json:"summoner2Casts"`
+		Summoner2ID                    int         `json:"summoner2Id"`
+		SummonerID                     string      `json:"summonerId"`
+		SummonerLevel                  int         `json:"summonerLevel"`
+		SummonerName                   string      `json:"summonerName"`
+		TeamEarlySurrendered           bool        `json:"teamEarlySurrendered"`
+		TeamID                         int         `json:"teamId"`
+		TeamPosition                   string      `json:"teamPosition"`
+		TimeCCingOthers                int         `json:"timeCCingOthers"`
+		TimePlayed                     int         `json:"timePlayed"`
+		TotalAllyJungleMinionsKilled   int         `json:"totalAllyJungleMinionsKilled"`
+		TotalDamageDealt               int         `json:"totalDamageDealt"`
+		TotalDamageDealtToChampions    int         `json:"totalDamageDealtToChampions"`
+		TotalDamageShieldedOnTeammates int         `json:"totalDamageShieldedOnTeammates"`
+		TotalDamageTaken               int         `json:"totalDamageTaken"`
+		TotalEnemyJungleMinionsKilled  int         `json:"totalEnemyJungleMinionsKilled"`
+		TotalHeal                      int         `json:"totalHeal"`
+		TotalHealsOnTeammates          int         `json:"totalHealsOnTeammates"`
+		TotalMinionsKilled             int         `json:"totalMinionsKilled"`
+		TotalTimeCCDealt               int         `json:"totalTimeCCDealt"`
+		TotalTimeSpentDead             int         `json:"totalTimeSpentDead"`
+		TotalUnitsHealed               int         `json:"totalUnitsHealed"`
+		TripleKills                    int         `json:"tripleKills"`
+		TrueDamageDealt                int         `json:"trueDamageDealt"`
+		TrueDamageDealtToChampions     int         `json:"trueDamageDealtToChampions"`
+		TrueDamageTaken                int         `json:"trueDamageTaken"`
+		TurretKills                    int         `json:"turretKills"`
+		TurretTakedowns                int         `json:"turretTakedowns"`
+		TurretsLost                    int         `json:"turretsLost"`
+		UnrealKills                    int         `json:"unrealKills"`
+		VisionClearedPings             int         `json:"visionClearedPings"`
+		VisionScore                    int         `json:"visionScore"`
+		VisionWardsBoughtInGame        int         `json:"visionWardsBoughtInGame"`
+		WardsKilled                    int         `json:"wardsKilled"`
+		WardsPlaced                    int         `json:"wardsPlaced"`
+		Win                            bool        `json:"win"`
+	}
+
+	// Challenges are the stats tracked for the challenges system, missing on older matches.
+	// Ratios and averages are decoded as float64, counts as int.
+	Challenges struct {
+		AssistStreakCount12                       int     `json:"12AssistStreakCount"`
+		AbilityUses                               int     `json:"abilityUses"`
+		AcesBefore15Minutes                       int     `json:"acesBefore15Minutes"`
+		AlliedJungleMonsterKills                  float64 `json:"alliedJungleMonsterKills"`
+		BaronTakedowns                            int     `json:"baronTakedowns"`
+		BlastConeOppositeOpponentCount            int     `json:"blastConeOppositeOpponentCount"`
+		BountyGold                                float64 `json:"bountyGold"`
+		BuffsStolen                               int     `json:"buffsStolen"`
+		CompleteSupportQuestInTime                int     `json:"completeSupportQuestInTime"`
+		ControlWardTimeCoverageInRiverOrEnemyHalf float64 `json:"controlWardTimeCoverageInRiverOrEnemyHalf"`
+		ControlWardsPlaced                        int     `json:"controlWardsPlaced"`
+		DamagePerMinute                           float64 `json:"damagePerMinute"`
+		DamageTakenOnTeamPercentage               float64 `json:"damageTakenOnTeamPercentage"`
+		DancedWithRiftHerald                      int     `json:"dancedWithRiftHerald"`
+		DeathsByEnemyChamps                       int     `json:"deathsByEnemyChamps"`
+		DodgeSkillShotsSmallWindow                int     `json:"dodgeSkillShotsSmallWindow"`
+		DoubleAces                                int     `json:"doubleAces"`
+		DragonTakedowns                           int     `json:"dragonTakedowns"`
+		EarliestBaron                             float64 `json:"earliestBaron"`
+		EarliestDragonTakedown                    float64 `json:"earliestDragonTakedown"`
+		EarlyLaningPhaseGoldExpAdvantage          float64 `json:"earlyLaningPhaseGoldExpAdvantage"`
+		EffectiveHealAndShielding                 float64 `json:"effectiveHealAndShielding"`
+		ElderDragonKillsWithOpposingSoul          int     `json:"elderDragonKillsWithOpposingSoul"`
+		ElderDragonMultikills                     int     `json:"elderDragonMultikills"`
+		EnemyChampionImmobilizations              int     `json:"enemyChampionImmobilizations"`
+		EnemyJungleMonsterKills                   float64 `json:"enemyJungleMonsterKills"`
+		EpicMonsterKillsNearEnemyJungler          int     `json:"epicMonsterKillsNearEnemyJungler"`
+		EpicMonsterKillsWithin30SecondsOfSpawn    int     `json:"epicMonsterKillsWithin30SecondsOfSpawn"`
+		EpicMonsterSteals                         int     `json:"epicMonsterSteals"`
+		EpicMonsterStolenWithoutSmite             int     `json:"epicMonsterStolenWithoutSmite"`
+		FirstTurretKilled                         float64 `json:"firstTurretKilled"`
+		FirstTurretKilledTime                     float64 `json:"firstTurretKilledTime"`
+		FlawlessAces                              int     `json:"flawlessAces"`
+		FullTeamTakedown                          int     `json:"fullTeamTakedown"`
+		GameLength                                float64 `json:"gameLength"`
+		GetTakedownsInAllLanesEarlyJungleAsLaner  int     `json:"getTakedownsInAllLanesEarlyJungleAsLaner"`
+		GoldPerMinute                             float64 `json:"goldPerMinute"`
+		HadOpenNexus                              int     `json:"hadOpenNexus"`
+		ImmobilizeAndKillWithAlly                 int     `json:"immobilizeAndKillWithAlly"`
+		InitialBuffCount                          int     `json:"initialBuffCount"`
+		InitialCrabCount                          int     `json:"initialCrabCount"`
+		JungleCsBefore10Minutes                   float64 `json:"jungleCsBefore10Minutes"`
+		JunglerTakedownsNearDamagedEpicMonster    int     `json:"junglerTakedownsNearDamagedEpicMonster"`
+		KDA                                       float64 `json:"kda"`
+		KillAfterHiddenWithAlly                   int     `json:"killAfterHiddenWithAlly"`
+		KillParticipation                         float64 `json:"killParticipation"`
+		KilledChampTookFullTeamDamageSurvived     int     `json:"killedChampTookFullTeamDamageSurvived"`
+		KillingSprees                             int     `json:"killingSprees"`
+		KillsNearEnemyTurret                      int     `json:"killsNearEnemyTurret"`
+		KillsOnOtherLanesEarlyJungleAsLaner       int     `json:"killsOnOtherLanesEarlyJungleAsLaner"`
+		KillsOnRecentlyHealedByAramPack           int     `json:"killsOnRecentlyHealedByAramPack"`
+		KillsUnderOwnTurret                       int     `json:"killsUnderOwnTurret"`
+		KillsWithHelpFromEpicMonster              int     `json:"killsWithHelpFromEpicMonster"`
+		KnockEnemyIntoTeamAndKill                 int     `json:"knockEnemyIntoTeamAndKill"`
+		KTurretsDestroyedBeforePlatesFall         int     `json:"kTurretsDestroyedBeforePlatesFall"`
+		LandSkillShotsEarlyGame                   int     `json:"landSkillShotsEarlyGame"`
+		LaneMinionsFirst10Minutes                 int     `json:"laneMinionsFirst10Minutes"`
+		LaningPhaseGoldExpAdvantage               float64 `json:"laningPhaseGoldExpAdvantage"`
+		LegendaryCount                            int     `json:"legendaryCount"`
+		LostAnInhibitor                           int     `json:"lostAnInhibitor"`
+		MaxCsAdvantageOnLaneOpponent              float64 `json:"maxCsAdvantageOnLaneOpponent"`
+		MaxKillDeficit                            int     `json:"maxKillDeficit"`
+		MaxLevelLeadLaneOpponent                  int     `json:"maxLevelLeadLaneOpponent"`
+		MejaisFullStackInTime                     int     `json:"mejaisFullStackInTime"`
+		MoreEnemyJungleThanOpponent               float64 `json:"moreEnemyJungleThanOpponent"`
+		MultiKillOneSpell                         int     `json:"multiKillOneSpell"`
+		MultiTurretRiftHeraldCount                int     `json:"multiTurretRiftHeraldCount"`
+		Multikills                                int     `json:"multikills"`
+		MultikillsAfterAggressiveFlash            int     `json:"multikillsAfterAggressiveFlash"`
+		OuterTurretExecutesBefore10Minutes        int     `json:"outerTurretExecutesBefore10Minutes"`
+		OutnumberedKills                          int     `json:"outnumberedKills"`
+		OutnumberedNexusKill                      int     `json:"outnumberedNexusKill"`
+		PerfectDragonSoulsTaken                   int     `json:"perfectDragonSoulsTaken"`
+		PerfectGame                               int     `json:"perfectGame"`
+		PickKillWithAlly                          int     `json:"pickKillWithAlly"`
+		PlayedChampSelectPosition                 int     `json:"playedChampSelectPosition"`
+		PoroExplosions                            int     `json:"poroExplosions"`
+		QuickCleanse                              int     `json:"quickCleanse"`
+		QuickFirstTurret                          int     `json:"quickFirstTurret"`
+		QuickSoloKills                            int     `json:"quickSoloKills"`
+		RiftHeraldTakedowns                       int     `json:"riftHeraldTakedowns"`
+		SaveAllyFromDeath                         int     `json:"saveAllyFromDeath"`
+		ScuttleCrabKills                          int     `json:"scuttleCrabKills"`
+		ShortestTimeToAceFromFirstTakedown        float64 `json:"shortestTimeToAceFromFirstTakedown"`
+		SkillshotsDodged                          int     `json:"skillshotsDodged"`
+		SkillshotsHit                             int     `json:"skillshotsHit"`
+		SnowballsHit                              int     `json:"snowballsHit"`
+		SoloBaronKills                            int     `json:"soloBaronKills"`
+		SoloKills                                 int     `json:"soloKills"`
+		SoloTurretsLategame                       int     `json:"soloTurretsLategame"`
+		StealthWardsPlaced                        int     `json:"stealthWardsPlaced"`
+		SurvivedSingleDigitHpCount                int     `json:"survivedSingleDigitHpCount"`
+		SurvivedThreeImmobilizesInFight           int     `json:"survivedThreeImmobilizesInFight"`
+		TakedownOnFirstTurret                     int     `json:"takedownOnFirstTurret"`
+		Takedowns                                 int     `json:"takedowns"`
+		TakedownsAfterGainingLevelAdvantage       int     `json:"takedownsAfterGainingLevelAdvantage"`
+		TakedownsBeforeJungleMinionSpawn          int     `json:"takedownsBeforeJungleMinionSpawn"`
+		TakedownsFirstXMinutes                    int     `json:"takedownsFirstXMinutes"`
+		TakedownsInAlcove                         int     `json:"takedownsInAlcove"`
+		TakedownsInEnemyFountain                  int     `json:"takedownsInEnemyFountain"`
+		TeamBaronKills                            int     `json:"teamBaronKills"`
+		TeamDamagePercentage                      float64 `json:"teamDamagePercentage"`
+		TeamElderDragonKills                      int     `json:"teamElderDragonKills"`
+		TeamRiftHeraldKills                       int     `json:"teamRiftHeraldKills"`
+		TookLargeDamageSurvived                   int     `json:"tookLargeDamageSurvived"`
+		TurretPlatesTaken                         int     `json:"turretPlatesTaken"`
+		TurretTakedowns                           int     `json:"turretTakedowns"`
+		TurretsTakenWithRiftHerald                int     `json:"turretsTakenWithRiftHerald"`
+		TwentyMinionsIn3SecondsCount              int     `json:"twentyMinionsIn3SecondsCount"`
+		TwoWardsOneSweeperCount                   int     `json:"twoWardsOneSweeperCount"`
+		UnseenRecalls                             int     `json:"unseenRecalls"`
+		VisionScoreAdvantageLaneOpponent          float64 `json:"visionScoreAdvantageLaneOpponent"`
+		VisionScorePerMinute                      float64 `json:"visionScorePerMinute"`
+		VoidMonsterKill                           int     `json:"voidMonsterKill"`
+		WardTakedowns                             int     `json:"wardTakedowns"`
+		WardTakedownsBefore20M                    int     `json:"wardTakedownsBefore20M"`
+		WardsGuarded                              int     `json:"wardsGuarded"`
+	}
+
+	Missions struct {
+		PlayerScore0  float64 `json:"playerScore0"`
+		PlayerScore1  float64 `json:"playerScore1"`
+		PlayerScore2  float64 `json:"playerScore2"`
+		PlayerScore3  float64 `json:"playerScore3"`
+		PlayerScore4  float64 `json:"playerScore4"`
+		PlayerScore5  float64 `json:"playerScore5"`
+		PlayerScore6  float64 `json:"playerScore6"`
+		PlayerScore7  float64 `json:"playerScore7"`
+		PlayerScore8  float64 `json:"playerScore8"`
+		PlayerScore9  float64 `json:"playerScore9"`
+		PlayerScore10 float64 `json:"playerScore10"`
+		PlayerScore11 float64 `json:"playerScore11"`
+	}
+
+	Perks struct {
+		StatPerks PerkStats   `json:"statPerks"`
+		Styles    []PerkStyle `json:"styles"`
+	}
+
+	PerkStats struct {
+		Defense int `json:"defense"`
+		Flex    int `json:"flex"`
+		Offense int `json:"offense"`
+	}
+
+	PerkStyle struct {
+		Description string               `json:"description"`
+		Selections  []PerkStyleSelection `json:"selections"`
+		Style       int                  `json:"style"`
+	}
+
+	PerkStyleSelection struct {
+		Perk int `json:"perk"`
+		Var1 int `json:"var1"`
+		Var2 int `json:"var2"`
+		Var3 int `json:"var3"`
+	}
+
+	Team struct {
+		Bans       []Ban      `json:"bans"`
+		Objectives Objectives `json:"objectives"`
+		TeamID     int        `json:"teamId"`
+		Win        bool       `json:"win"`
+	}
+
+	Ban struct {
+		ChampionID int `json:"championId"`
+		PickTurn   int `json:"pickTurn"`
+	}
+
+	Objectives struct {
+		Atakhan    Objective `json:"atakhan"`
+		Baron      Objective `json:"baron"`
+		Champion   Objective `json:"champion"`
+		Dragon     Objective `json:"dragon"`
+		Horde      Objective `json:"horde"`
+		Inhibitor  Objective `json:"inhibitor"`
+		RiftHerald Objective `json:"riftHerald"`
+		Tower      Objective `json:"tower"`
+	}
+
+	Objective struct {
+		First bool `json:"first"`
+		Kills int  `json:"kills"`
+	}
+
+	Timeline struct {
+		Metadata Metadata     `json:"metadata"`
+		Info     TimelineInfo `json:"info"`
+	}
+
+	TimelineInfo struct {
+		EndOfGameResult string                `json:"endOfGameResult"`
+		FrameInterval   int64                 `json:"frameInterval"`
+		Frames          []Frame               `json:"frames"`
+		GameID          int64                 `json:"gameId"`
+		Participants    []TimelineParticipant `json:"participants"`
+	}
+
+	TimelineParticipant struct {
+		ParticipantID int    `json:"participantId"`
+		PUUID         string `json:"puuid"`
+	}
+
+	// Frame is the state of the game at the timestamp, keyed by participant ID on the participant frames.
+	Frame struct {
+		Events            Events                      `json:"events"`
+		ParticipantFrames map[string]ParticipantFrame `json:"participantFrames"`
+		Timestamp         int64                       `json:"timestamp"`
+	}
+
+	ParticipantFrame struct {
+		ChampionStats            ChampionStats `json:"championStats"`
+		CurrentGold              int           `json:"currentGold"`
+		DamageStats              DamageStats   `json:"damageStats"`
+		GoldPerSecond            int           `json:"goldPerSecond"`
+		JungleMinionsKilled      int           `json:"jungleMinionsKilled"`
+		Level                    int           `json:"level"`
+		MinionsKilled            int           `json:"minionsKilled"`
+		ParticipantID            int           `json:"participantId"`
+		Position                 Position      `json:"position"`
+		TimeEnemySpentControlled int           `json:"timeEnemySpentControlled"`
+		TotalGold                int           `json:"totalGold"`
+		XP                       int           `json:"xp"`
+	}
+
+	ChampionStats struct {
+		AbilityHaste         int `json:"abilityHaste"`
+		AbilityPower         int `json:"abilityPower"`
+		Armor                int `json:"armor"`
+		ArmorPen             int `json:"armorPen"`
+		ArmorPenPercent      int `json:"armorPenPercent"`
+		AttackDamage         int `json:"attackDamage"`
+		AttackSpeed          int `json:"attackSpeed"`
+		BonusArmorPenPercent int `json:"bonusArmorPenPercent"`
+		BonusMagicPenPercent int `json:"bonusMagicPenPercent"`
+		CCReduction          int `json:"ccReduction"`
+		CooldownReduction    int `json:"cooldownReduction"`
+		Health               int `json:"health"`
+		HealthMax            int `json:"healthMax"`
+		HealthRegen          int `json:"healthRegen"`
+		Lifesteal            int `json:"lifesteal"`
+		MagicPen             int `json:"magicPen"`
+		MagicPenPercent      int `json:"magicPenPercent"`
+		MagicResist          int `json:"magicResist"`
+		MovementSpeed        int `json:"movementSpeed"`
+		Omnivamp             int `json:"omnivamp"`
+		PhysicalVamp         int `json:"physicalVamp"`
+		Power                int `json:"power"`
+		PowerMax             int `json:"powerMax"`
+		PowerRegen           int `json:"powerRegen"`
+		SpellVamp            int `json:"spellVamp"`
+	}
+
+	DamageStats struct {
+		MagicDamageDone               int `json:"magicDamageDone"`
+		MagicDamageDoneToChampions    int `json:"magicDamageDoneToChampions"`
+		MagicDamageTaken              int `json:"magicDamageTaken"`
+		PhysicalDamageDone            int `json:"physicalDamageDone"`
+		PhysicalDamageDoneToChampions int `json:"physicalDamageDoneToChampions"`
+		PhysicalDamageTaken           int `json:"physicalDamageTaken"`
+		TotalDamageDone               int `json:"totalDamageDone"`
+		TotalDamageDoneToChampions    int `json:"totalDamageDoneToChampions"`
+		TotalDamageTaken              int `json:"totalDamageTaken"`
+		TrueDamageDone                int `json:"trueDamageDone"`
+		TrueDamageDoneToChampions     int `json:"trueDamageDoneToChampions"`
+		TrueDamageTaken               int `json:"trueDamageTaken"`
+	}
+
+	Position struct {
+		X int `json:"x"`
+		Y int `json:"y"`
+	}
+
+	// Type is the match type used to filter the match IDs.
+	Type string
+)
+
+const (
+	TypeRanked   Type = "ranked"
+	TypeNormal   Type = "normal"
+	TypeTourney  Type = "tourney"
+	TypeTutorial Type = "tutorial"
+)
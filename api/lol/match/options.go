@@ -0,0 +1,49 @@
+package match
+
+import (
+	"leago/internal"
+	"strconv"
+	"time"
+)
+
+type GetMatchIDsOption internal.RequestOption
+
+// WithStart sets the start index of the returned match IDs (Default 0).
+func WithStart(start int) GetMatchIDsOption {
+	return GetMatchIDsOption(internal.WithParam("start", strconv.Itoa(start)))
+}
+
+// WithCount sets the number of match IDs returned, between 0 and 100 (Default 20).
+func WithCount(count int) GetMatchIDsOption {
+	return GetMatchIDsOption(internal.WithParam("count", strconv.Itoa(count)))
+}
+
+// WithQueue filters the match IDs by queue ID, like 420 for ranked solo.
+func WithQueue(queue int) GetMatchIDsOption {
+	return GetMatchIDsOption(internal.WithParam("queue", strconv.Itoa(queue)))
+}
+
+// WithType filters the match IDs by match type.
+func WithType(matchType Type) GetMatchIDsOption {
+	return GetMatchIDsOption(internal.WithParam("type", string(matchType)))
+}
+
+// WithStartTime filters the match IDs to matches played after the time.
+// Riot only keeps match IDs after June 16th, 2021.
+func WithStartTime(startTime time.Time) GetMatchIDsOption {
+	return GetMatchIDsOption(internal.WithParam("startTime", strconv.FormatInt(startTime.Unix(), 10)))
+}
+
+// WithEndTime filters the match IDs to matches played before the time.
+func WithEndTime(endTime time.Time) GetMatchIDsOption {
+	return GetMatchIDsOption(internal.WithParam("endTime", strconv.FormatInt(endTime.Unix(), 10)))
+}
+
+// getMatchIDsOptionsToRequestOptions converts the array of options into internal request options.
+func getMatchIDsOptionsToRequestOptions(opts []GetMatchIDsOption) []internal.RequestOption {
+	out := make([]internal.RequestOption, len(opts))
+	for i, o := range opts {
+		out[i] = internal.RequestOption(o)
+	}
+	return out
+}
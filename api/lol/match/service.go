@@ -0,0 +1,89 @@
+package match
+
+import (
+	"context"
+	"fmt"
+	"leago/internal"
+	"leago/options"
+	"net/url"
+)
+
+const (
+	MethodGetMatchIDsByPUUID = "Match.GetMatchIDsByPUUID"
+	MethodGetMatch           = "Match.GetMatch"
+	MethodGetTimeline        = "Match.GetTimeline"
+)
+
+// GetMatchIDsByPUUID returns a list of match IDs played by the player, most recent first.
+func (rc *RegionClient) GetMatchIDsByPUUID(
+	ctx context.Context,
+	puuid string,
+	endpointOpts []GetMatchIDsOption,
+	opts ...options.PublicOption,
+) ([]string, error) {
+	endpoint := fmt.Sprintf(
+		"/lol/match/v5/matches/by-puuid/%s/ids",
+		url.PathEscape(puuid),
+	)
+
+	defaultOpts := append(
+		[]internal.RequestOption{internal.WithApiMethod(MethodGetMatchIDsByPUUID)},
+		getMatchIDsOptionsToRequestOptions(endpointOpts)...,
+	)
+
+	uri := rc.client.GetURL(endpoint)
+	return internal.AuthRequest[[]string](
+		ctx,
+		rc.client,
+		uri,
+		options.MergeOptions(defaultOpts, opts)...,
+	)
+}
+
+// GetMatch returns the match details got by the matchID, like "NA1_4900000000".
+func (rc *RegionClient) GetMatch(
+	ctx context.Context,
+	matchID string,
+	opts ...options.PublicOption,
+) (Match, error) {
+	endpoint := fmt.Sprintf(
+		"/lol/match/v5/matches/%s",
+		url.PathEscape(matchID),
+	)
+
+	defaultOpts := []internal.RequestOption{
+		internal.WithApiMethod(MethodGetMatch),
+	}
+
+	uri := rc.client.GetURL(endpoint)
+	return internal.AuthRequest[Match](
+		ctx,
+		rc.client,
+		uri,
+		options.MergeOptions(defaultOpts, opts)...,
+	)
+}
+
+// GetTimeline returns the match timeline got by the matchID, with the state of the game on each frame.
+func (rc *RegionClient) GetTimeline(
+	ctx context.Context,
+	matchID string,
+	opts ...options.PublicOption,
+) (Timeline, error) {
+	endpoint := fmt.Sprintf(
+		"/lol/match/v5/matches/%s/timeline",
+		url.PathEscape(matchID),
+	)
+
+	defaultOpts := []internal.RequestOption{
+		internal.WithApiMethod(MethodGetTimeline),
+	}
+
+	uri := rc.client.GetURL(endpoint)
+	return internal.AuthRequest[Timeline](
+		ctx,
+		rc.client,
+		uri,
+		options.MergeOptions(defaultOpts, opts)...,
+	)
+}
@@ -0,0 +1,393 @@
+package match
+
+import (
+	"context"
+	"leago/internal"
+	"leago/internal/mock"
+	"leago/regions"
+	"log/slog"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	expectedMatch = Match{
+		Metadata: Metadata{
+			DataVersion:  "2",
+			MatchID:      "BR1_3000000000",
+			Participants: []string{"puuid-1"},
+		},
+		Info: Info{
+			EndOfGameResult:    "GameComplete",
+			GameCreation:       1700000000000,
+			GameDuration:       1800,
+			GameEndTimestamp:   1700001900000,
+			GameID:             3000000000,
+			GameMode:           "CLASSIC",
+			GameStartTimestamp: 1700000100000,
+			GameType:           "MATCHED_GAME",
+			GameVersion:        "14.1.555.5555",
+			MapID:              11,
+			Participants: []Participant{
+				{
+					Assists:      7,
+					Challenges:   &Challenges{AssistStreakCount12: 1, KDA: 4.5, KillParticipation: 0.62},
+					ChampionID:   103,
+					ChampionName: "Ahri",
+					Deaths:       2,
+					Kills:        2,
+					Perks: Perks{
+						StatPerks: PerkStats{Defense: 5001, Flex: 5008, Offense: 5005},
+						Styles: []PerkStyle{
+							{
+								Description: "primaryStyle",
+								Selections:  []PerkStyleSelection{{Perk: 8112, Var1: 1200}},
+								Style:       8100,
+							},
+						},
+					},
+					PUUID:          "puuid-1",
+					RiotIDGameName: "Player",
+					RiotIDTagline:  "BR1",
+					TeamID:         100,
+					TeamPosition:   "MIDDLE",
+					Win:            true,
+				},
+			},
+			PlatformID: "BR1",
+			QueueID:    420,
+			Teams: []Team{
+				{
+					Bans: []Ban{{ChampionID: 157, PickTurn: 1}},
+					Objectives: Objectives{
+						Baron: Objective{First: true, Kills: 1},
+						Tower: Objective{First: false, Kills: 9},
+					},
+					TeamID: 100,
+					Win:    true,
+				},
+			},
+		},
+	}
+
+	matchJSON = `{
+		"metadata":{"dataVersion":"2","matchId":"BR1_3000000000","participants":["puuid-1"]},
+		"info":{
+			"endOfGameResult":"GameComplete",
+			"gameCreation":1700000000000,
+			"gameDuration":1800,
+			"gameEndTimestamp":1700001900000,
+			"gameId":3000000000,
+			"gameMode":"CLASSIC",
+			"gameStartTimestamp":1700000100000,
+			"gameType":"MATCHED_GAME",
+			"gameVersion":"14.1.555.5555",
+			"mapId":11,
+			"participants":[{
+				"assists":7,
+				"challenges":{"12AssistStreakCount":1,"kda":4.5,"killParticipation":0.62},
+				"championId":103,
+				"championName":"Ahri",
+				"deaths":2,
+				"kills":2,
+				"perks":{
+					"statPerks":{"defense":5001,"flex":5008,"offense":5005},
+					"styles":[{"description":"primaryStyle","selections":[{"perk":8112,"var1":1200,"var2":0,"var3":0}],"style":8100}]
+				},
+				"puuid":"puuid-1",
+				"riotIdGameName":"Player",
+				"riotIdTagline":"BR1",
+				"teamId":100,
+				"teamPosition":"MIDDLE",
+				"win":true
+			}],
+			"platformId":"BR1",
+			"queueId":420,
+			"teams":[{
+				"bans":[{"championId":157,"pickTurn":1}],
+				"objectives":{"baron":{"first":true,"kills":1},"tower":{"first":false,"kills":9}},
+				"teamId":100,
+				"win":true
+			}]
+		}
+	}`
+
+	expectedTimeline = Timeline{
+		Metadata: Metadata{
+			DataVersion:  "2",
+			MatchID:      "BR1_3000000000",
+			Participants: []string{"puuid-1"},
+		},
+		Info: TimelineInfo{
+			FrameInterval: 60000,
+			Frames: []Frame{
+				{
+					Events: Events{
+						&PauseEnd{
+							EventBase:     EventBase{Timestamp: 0, Type: EventTypePauseEnd},
+							RealTimestamp: 1700000100000,
+						},
+					},
+					ParticipantFrames: map[string]ParticipantFrame{
+						"1": {
+							ChampionStats: ChampionStats{Health: 640, HealthMax: 640},
+							CurrentGold:   500,
+							Level:         1,
+							ParticipantID: 1,
+							Position:      Position{X: 554, Y: 581},
+							TotalGold:     500,
+						},
+					},
+					Timestamp: 0,
+				},
+			},
+			GameID:       3000000000,
+			Participants: []TimelineParticipant{{ParticipantID: 1, PUUID: "puuid-1"}},
+		},
+	}
+
+	timelineJSON = `{
+		"metadata":{"dataVersion":"2","matchId":"BR1_3000000000","participants":["puuid-1"]},
+		"info":{
+			"frameInterval":60000,
+			"frames":[{
+				"events":[{"realTimestamp":1700000100000,"timestamp":0,"type":"PAUSE_END"}],
+				"participantFrames":{
+					"1":{
+						"championStats":{"health":640,"healthMax":640},
+						"currentGold":500,
+						"level":1,
+						"participantId":1,
+						"position":{"x":554,"y":581},
+						"totalGold":500
+					}
+				},
+				"timestamp":0
+			}],
+			"gameId":3000000000,
+			"participants":[{"participantId":1,"puuid":"puuid-1"}]
+		}
+	}`
+)
+
+func TestGetMatchIDsByPUUID(t *testing.T) {
+	tests := []struct {
+		name           string
+		statusCode     int
+		puuid          string
+		httpErr        error
+		responseBody   string
+		expectedResult []string
+		wantErr        bool
+		wantRiotErr    bool
+	}{
+		{
+			name:         "riot error",
+			puuid:        "test-puuid",
+			statusCode:   http.StatusBadRequest,
+			responseBody: `{"status":{"status_code":400}}`,
+			wantErr:      true,
+			wantRiotErr:  true,
+		},
+		{
+			name:         "unmatched json",
+			puuid:        "test-puuid",
+			statusCode:   http.StatusOK,
+			responseBody: `{"id":"BR1_3000000000"}`,
+			wantErr:      true,
+			wantRiotErr:  false,
+		},
+		{
+			name:           "success",
+			puuid:          "test-puuid",
+			statusCode:     http.StatusOK,
+			responseBody:   `["BR1_3000000001","BR1_3000000000"]`,
+			expectedResult: []string{"BR1_3000000001", "BR1_3000000000"},
+			wantErr:        false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDoer := mock.NewDefaultDoer(tt.statusCode, tt.responseBody, tt.httpErr)
+			baseClient := internal.NewHttpClient(
+				mockDoer,
+				slog.Default(),
+				string(regions.RegionAmericas),
+				"apiKey",
+			)
+			rc := NewRegionClient(baseClient)
+
+			startTime := time.Unix(1700000000, 0)
+			resp, err := rc.GetMatchIDsByPUUID(
+				context.Background(),
+				tt.puuid,
+				[]GetMatchIDsOption{
+					WithStart(10),
+					WithCount(50),
+					WithQueue(420),
+					WithType(TypeRanked),
+					WithStartTime(startTime),
+					WithEndTime(startTime.Add(time.Hour)),
+				},
+			)
+
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				if tt.wantRiotErr {
+					var rErr *internal.RiotError
+					assert.ErrorAs(t, err, &rErr)
+					assert.Equal(t, tt.statusCode, rErr.StatusCode)
+				}
+				return
+			}
+
+			require.Nil(t, err)
+
+			query := mockDoer.CapturedReq.URL.Query()
+			assert.Equal(t, "/lol/match/v5/matches/by-puuid/test-puuid/ids", mockDoer.CapturedReq.URL.Path)
+			assert.Equal(t, "10", query.Get("start"))
+			assert.Equal(t, "50", query.Get("count"))
+			assert.Equal(t, "420", query.Get("queue"))
+			assert.Equal(t, "ranked", query.Get("type"))
+			assert.Equal(t, "1700000000", query.Get("startTime"))
+			assert.Equal(t, "1700003600", query.Get("endTime"))
+
+			assert.Equal(t, tt.expectedResult, resp)
+		})
+	}
+}
+
+func TestGetMatch(t *testing.T) {
+	tests := []struct {
+		name           string
+		statusCode     int
+		matchID        string
+		httpErr        error
+		responseBody   string
+		expectedResult Match
+		wantErr        bool
+		wantRiotErr    bool
+	}{
+		{
+			name:         "riot error",
+			matchID:      "BR1_3000000000",
+			statusCode:   http.StatusNotFound,
+			responseBody: `{"status":{"status_code":404}}`,
+			wantErr:      true,
+			wantRiotErr:  true,
+		},
+		{
+			name:         "invalid json",
+			matchID:      "BR1_3000000000",
+			statusCode:   http.StatusOK,
+			responseBody: `{"metadata":`,
+			wantErr:      true,
+			wantRiotErr:  false,
+		},
+		{
+			name:           "success",
+			matchID:        "BR1_3000000000",
+			statusCode:     http.StatusOK,
+			responseBody:   matchJSON,
+			expectedResult: expectedMatch,
+			wantErr:        false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDoer := mock.NewDefaultDoer(tt.statusCode, tt.responseBody, tt.httpErr)
+			baseClient := internal.NewHttpClient(
+				mockDoer,
+				slog.Default(),
+				string(regions.RegionAmericas),
+				"apiKey",
+			)
+			rc := NewRegionClient(baseClient)
+			resp, err := rc.GetMatch(context.Background(), tt.matchID)
+
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				if tt.wantRiotErr {
+					var rErr *internal.RiotError
+					assert.ErrorAs(t, err, &rErr)
+					assert.Equal(t, tt.statusCode, rErr.StatusCode)
+				}
+				return
+			}
+
+			require.Nil(t, err)
+			assert.Equal(t, tt.expectedResult, resp)
+		})
+	}
+}
+
+func TestGetTimeline(t *testing.T) {
+	tests := []struct {
+		name           string
+		statusCode     int
+		matchID        string
+		httpErr        error
+		responseBody   string
+		expectedResult Timeline
+		wantErr        bool
+		wantRiotErr    bool
+	}{
+		{
+			name:         "riot error",
+			matchID:      "BR1_3000000000",
+			statusCode:   http.StatusForbidden,
+			responseBody: `{"status":{"status_code":403}}`,
+			wantErr:      true,
+			wantRiotErr:  true,
+		},
+		{
+			name:         "unmatched json",
+			matchID:      "BR1_3000000000",
+			statusCode:   http.StatusOK,
+			responseBody: `["frame"]`,
+			wantErr:      true,
+			wantRiotErr:  false,
+		},
+		{
+			name:           "success",
+			matchID:        "BR1_3000000000",
+			statusCode:     http.StatusOK,
+			responseBody:   timelineJSON,
+			expectedResult: expectedTimeline,
+			wantErr:        false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDoer := mock.NewDefaultDoer(tt.statusCode, tt.responseBody, tt.httpErr)
+			baseClient := internal.NewHttpClient(
+				mockDoer,
+				slog.Default(),
+				string(regions.RegionAmericas),
+				"apiKey",
+			)
+			rc := NewRegionClient(baseClient)
+			resp, err := rc.GetTimeline(context.Background(), tt.matchID)
+
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				if tt.wantRiotErr {
+					var rErr *internal.RiotError
+					assert.ErrorAs(t, err, &rErr)
+					assert.Equal(t, tt.statusCode, rErr.StatusCode)
+				}
+				return
+			}
+
+			require.Nil(t, err)
+			assert.Equal(t, tt.expectedResult, resp)
+		})
+	}
+}
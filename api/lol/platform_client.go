@@ -7,9 +7,13 @@ import (
 	"leago/api/lol/clash"
 	"leago/api/lol/league"
 	"leago/api/lol/leagueexp"
+	"leago/api/lol/spectator"
+	"leago/api/lol/status"
+	"leago/api/lol/summoner"
 	"leago/internal"
 	"leago/regions"
 	"log/slog"
+	"slices"
 )
 
 type PlatformClient struct {
@@ -19,10 +23,20 @@ type PlatformClient struct {
 	Clash           *clash.PlatformClient
 	League          *league.PlatformClient
 	LeagueExp       *leagueexp.PlatformClient
+	Spectator       *spectator.PlatformClient
+	Status          *status.PlatformClient
+	Summoner        *summoner.PlatformClient
 }
 
-func NewPlatformClient(client internal.Doer, logger *slog.Logger, region regions.Platform, apiKey string) *PlatformClient {
-	baseClient := internal.NewHttpClient(client, logger, string(region), apiKey)
+func NewPlatformClient(
+	client internal.Doer,
+	logger *slog.Logger,
+	region regions.Platform,
+	apiKey string,
+	opts ...internal.ClientOption,
+) *PlatformClient {
+	opts = append(slices.Clip(opts), internal.WithProduct(regions.ProductLoL))
+	baseClient := internal.NewHttpClient(client, logger, string(region), apiKey, opts...)
 	c := &PlatformClient{
 		Challenges:      challenges.NewPlatformClient(baseClient),
 		ChampionMastery: championmastery.NewPlatformClient(baseClient),
@@ -30,6 +44,9 @@ func NewPlatformClient(client internal.Doer, logger *slog.Logger, region regions
 		Clash:           clash.NewPlatformClient(baseClient),
 		League:          league.NewPlatformClient(baseClient),
 		LeagueExp:       leagueexp.NewPlatformClient(baseClient),
+		Spectator:       spectator.NewPlatformClient(baseClient),
+		Status:          status.NewPlatformClient(baseClient),
+		Summoner:        summoner.NewPlatformClient(baseClient),
 	}
 	return c
 }
@@ -0,0 +1,34 @@
+package lol
+
+import (
+	"leago/api/lol/match"
+	"leago/api/lol/tournament"
+	"leago/api/lol/tournamentstub"
+	"leago/internal"
+	"leago/regions"
+	"log/slog"
+	"slices"
+)
+
+type RegionClient struct {
+	Match          *match.RegionClient
+	Tournament     *tournament.RegionClient
+	TournamentStub *tournamentstub.RegionClient
+}
+
+func NewRegionClient(
+	client internal.Doer,
+	logger *slog.Logger,
+	region regions.Region,
+	apiKey string,
+	opts ...internal.ClientOption,
+) *RegionClient {
+	opts = append(slices.Clip(opts), internal.WithProduct(regions.ProductLoL))
+	baseClient := internal.NewHttpClient(client, logger, string(region), apiKey, opts...)
+	c := &RegionClient{
+		Match:          match.NewRegionClient(baseClient),
+		Tournament:     tournament.NewRegionClient(baseClient),
+		TournamentStub: tournamentstub.NewRegionClient(baseClient),
+	}
+	return c
+}
@@ -0,0 +1,19 @@
+package lol
+
+import (
+	"leago/regions"
+	"log/slog"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRegionClient(t *testing.T) {
+	client := NewRegionClient(http.DefaultClient, slog.Default(), regions.RegionAmericas, "apiKey")
+	require.NotNil(t, client)
+
+	require.NotNil(t, client.Match)
+	require.NotNil(t, client.Tournament)
+	require.NotNil(t, client.TournamentStub)
+}
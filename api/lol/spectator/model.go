@@ -0,0 +1,82 @@
+package spectator
+
+type (
+	Game struct {
+		GameID            int64                `json:"gameId"`
+		GameType          string               `json:"gameType"`
+		GameStartTime     int64                `json:"gameStartTime"`
+		MapID             int                  `json:"mapId"`
+		GameLength        int64                `json:"gameLength"`
+		PlatformID        string               `json:"platformId"`
+		GameMode          string               `json:"gameMode"`
+		BannedChampions   []BannedChampion     `json:"bannedChampions"`
+		GameQueueConfigID int                  `json:"gameQueueConfigId"`
+		Observers         Observer             `json:"observers"`
+		Participants      []CurrentParticipant `json:"participants"`
+	}
+
+	CurrentParticipant struct {
+		ChampionID               int                       `json:"championId"`
+		Perks                    Perks                     `json:"perks"`
+		ProfileIconID            int                       `json:"profileIconId"`
+		Bot                      bool                      `json:"bot"`
+		TeamID                   int                       `json:"teamId"`
+		PUUID                    string                    `json:"puuid"`
+		RiotID                   string                    `json:"riotId"`
+		Spell1ID                 int                       `json:"spell1Id"`
+		Spell2ID                 int                       `json:"spell2Id"`
+		GameCustomizationObjects []GameCustomizationObject `json:"gameCustomizationObjects"`
+	}
+
+	Perks struct {
+		PerkIDs      []int `json:"perkIds"`
+		PerkStyle    int   `json:"perkStyle"`
+		PerkSubStyle int   `json:"perkSubStyle"`
+	}
+
+	GameCustomizationObject struct {
+		Category string `json:"category"`
+		Content  string `json:"content"`
+	}
+
+	BannedChampion struct {
+		PickTurn   int `json:"pickTurn"`
+		ChampionID int `json:"championId"`
+		TeamID     int `json:"teamId"`
+	}
+
+	// Observer has the key used by the client to spectate the game.
+	Observer struct {
+		EncryptionKey string `json:"encryptionKey"`
+	}
+
+	FeaturedGames struct {
+		GameList []FeaturedGame `json:"gameList"`
+		// ClientRefreshInterval is the suggested interval, in seconds, to wait before refreshing the featured games.
+		ClientRefreshInterval int64 `json:"clientRefreshInterval"`
+	}
+
+	FeaturedGame struct {
+		GameMode          string           `json:"gameMode"`
+		GameLength        int64            `json:"gameLength"`
+		MapID             int              `json:"mapId"`
+		GameType          string           `json:"gameType"`
+		BannedChampions   []BannedChampion `json:"bannedChampions"`
+		GameID            int64            `json:"gameId"`
+		Observers         Observer         `json:"observers"`
+		GameQueueConfigID int              `json:"gameQueueConfigId"`
+		Participants      []Participant    `json:"participants"`
+		PlatformID        string           `json:"platformId"`
+	}
+
+	Participant struct {
+		Bot           bool   `json:"bot"`
+		Spell2ID      int    `json:"spell2Id"`
+		ProfileIconID int    `json:"profileIconId"`
+		PUUID         string `json:"puuid"`
+		RiotID        string `json:"riotId"`
+		ChampionID    int    `json:"championId"`
+		TeamID        int    `json:"teamId"`
+		Spell1ID      int    `json:"spell1Id"`
+	}
+)
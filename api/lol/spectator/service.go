@@ -0,0 +1,71 @@
+package spectator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"leago/apierror"
+	"leago/internal"
+	"leago/options"
+	"net/http"
+	"net/url"
+)
+
+const (
+	MethodGetActiveGameByPUUID = "Spectator.GetActiveGameByPUUID"
+	MethodGetFeaturedGames     = "Spectator.GetFeaturedGames"
+)
+
+// GetActiveGameByPUUID returns the game the player is currently in.
+// A player not in game isn't an error, it's reported by inGame being false.
+func (pc *PlatformClient) GetActiveGameByPUUID(
+	ctx context.Context,
+	puuid string,
+	opts ...options.PublicOption,
+) (game Game, inGame bool, err error) {
+	endpoint := fmt.Sprintf(
+		"/lol/spectator/v5/active-games/by-summoner/%s",
+		url.PathEscape(puuid),
+	)
+
+	defaultOpts := []internal.RequestOption{
+		internal.WithApiMethod(MethodGetActiveGameByPUUID),
+		internal.WithExpectedStatus(http.StatusNotFound),
+	}
+
+	uri := pc.client.GetURL(endpoint)
+	game, err = internal.AuthRequest[Game](
+		ctx,
+		pc.client,
+		uri,
+		options.MergeOptions(defaultOpts, opts)...,
+	)
+	if errors.Is(err, apierror.ErrNotFound) {
+		return Game{}, false, nil
+	}
+	if err != nil {
+		return Game{}, false, err
+	}
+
+	return game, true, nil
+}
+
+// GetFeaturedGames returns the list of featured games shown on the client.
+func (pc *PlatformClient) GetFeaturedGames(
+	ctx context.Context,
+	opts ...options.PublicOption,
+) (FeaturedGames, error) {
+	endpoint := "/lol/spectator/v5/featured-games"
+
+	defaultOpts := []internal.RequestOption{
+		internal.WithApiMethod(MethodGetFeaturedGames),
+	}
+
+	uri := pc.client.GetURL(endpoint)
+	return internal.AuthRequest[FeaturedGames](
+		ctx,
+		pc.client,
+		uri,
+		options.MergeOptions(defaultOpts, opts)...,
+	)
+}
@@ -0,0 +1,240 @@
+package spectator
+
+import (
+	"bytes"
+	"context"
+	"leago/internal"
+	"leago/internal/mock"
+	"leago/regions"
+	"log/slog"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetActiveGameByPUUID(t *testing.T) {
+	tests := []struct {
+		name           string
+		statusCode     int
+		puuid          string
+		httpErr        error
+		responseBody   string
+		expectedResult Game
+		wantInGame     bool
+		wantErr        bool
+		wantRiotErr    bool
+	}{
+		{
+			name:         "not in game",
+			puuid:        "test-puuid",
+			statusCode:   http.StatusNotFound,
+			responseBody: `{"status":{"status_code":404,"message":"Data not found"}}`,
+			wantInGame:   false,
+			wantErr:      false,
+		},
+		{
+			name:         "riot error",
+			puuid:        "test-puuid",
+			statusCode:   http.StatusForbidden,
+			responseBody: `{"status":{"status_code":403}}`,
+			wantErr:      true,
+			wantRiotErr:  true,
+		},
+		{
+			name:         "unmatched json",
+			puuid:        "test-puuid",
+			statusCode:   http.StatusOK,
+			responseBody: `{"gameId":"not a number"}`,
+			wantErr:      true,
+			wantRiotErr:  false,
+		},
+		{
+			name:       "success",
+			puuid:      "test-puuid",
+			statusCode: http.StatusOK,
+			responseBody: `{
+				"gameId":3000000000,
+				"gameType":"MATCHED",
+				"gameStartTime":1700000000000,
+				"mapId":11,
+				"gameLength":325,
+				"platformId":"BR1",
+				"gameMode":"CLASSIC",
+				"bannedChampions":[{"pickTurn":1,"championId":157,"teamId":100}],
+				"gameQueueConfigId":420,
+				"observers":{"encryptionKey":"key"},
+				"participants":[{
+					"championId":103,
+					"perks":{"perkIds":[8112,8126],"perkStyle":8100,"perkSubStyle":8200},
+					"profileIconId":4568,
+					"bot":false,
+					"teamId":100,
+					"puuid":"test-puuid",
+					"riotId":"Player#BR1",
+					"spell1Id":4,
+					"spell2Id":14,
+					"gameCustomizationObjects":[{"category":"perks","content":"{}"}]
+				}]
+			}`,
+			expectedResult: Game{
+				GameID:            3000000000,
+				GameType:          "MATCHED",
+				GameStartTime:     1700000000000,
+				MapID:             11,
+				GameLength:        325,
+				PlatformID:        "BR1",
+				GameMode:          "CLASSIC",
+				BannedChampions:   []BannedChampion{{PickTurn: 1, ChampionID: 157, TeamID: 100}},
+				GameQueueConfigID: 420,
+				Observers:         Observer{EncryptionKey: "key"},
+				Participants: []CurrentParticipant{
+					{
+						ChampionID:               103,
+						Perks:                    Perks{PerkIDs: []int{8112, 8126}, PerkStyle: 8100, PerkSubStyle: 8200},
+						ProfileIconID:            4568,
+						TeamID:                   100,
+						PUUID:                    "test-puuid",
+						RiotID:                   "Player#BR1",
+						Spell1ID:                 4,
+						Spell2ID:                 14,
+						GameCustomizationObjects: []GameCustomizationObject{{Category: "perks", Content: "{}"}},
+					},
+				},
+			},
+			wantInGame: true,
+			wantErr:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDoer := mock.NewDefaultDoer(tt.statusCode, tt.responseBody, tt.httpErr)
+			baseClient := internal.NewHttpClient(mockDoer, slog.Default(), string(regions.PlatformBR1), "apiKey")
+			pc := NewPlatformClient(baseClient)
+			resp, inGame, err := pc.GetActiveGameByPUUID(context.Background(), tt.puuid)
+
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				assert.False(t, inGame)
+				if tt.wantRiotErr {
+					var rErr *internal.RiotError
+					assert.ErrorAs(t, err, &rErr)
+					assert.Equal(t, tt.statusCode, rErr.StatusCode)
+				}
+				return
+			}
+
+			require.Nil(t, err)
+			assert.Equal(t, tt.wantInGame, inGame)
+			assert.Equal(t, tt.expectedResult, resp)
+		})
+	}
+}
+
+func TestGetActiveGameByPUUIDNotInGameLogsDebug(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelWarn}))
+	mockDoer := mock.NewDefaultDoer(http.StatusNotFound, `{"status":{"status_code":404}}`, nil)
+	pc := NewPlatformClient(internal.NewHttpClient(mockDoer, logger, string(regions.PlatformBR1), "apiKey"))
+
+	_, inGame, err := pc.GetActiveGameByPUUID(context.Background(), "test-puuid")
+	require.NoError(t, err)
+	assert.False(t, inGame)
+	assert.Empty(t, buf.String())
+}
+
+func TestGetFeaturedGames(t *testing.T) {
+	tests := []struct {
+		name           string
+		statusCode     int
+		httpErr        error
+		responseBody   string
+		expectedResult FeaturedGames
+		wantErr        bool
+		wantRiotErr    bool
+	}{
+		{
+			name:         "riot error",
+			statusCode:   http.StatusServiceUnavailable,
+			responseBody: `{"status":{"status_code":503}}`,
+			wantErr:      true,
+			wantRiotErr:  true,
+		},
+		{
+			name:         "invalid json",
+			statusCode:   http.StatusOK,
+			responseBody: `{"gameList":[`,
+			wantErr:      true,
+			wantRiotErr:  false,
+		},
+		{
+			name:       "success",
+			statusCode: http.StatusOK,
+			responseBody: `{
+				"gameList":[{
+					"gameMode":"ARAM",
+					"gameLength":120,
+					"mapId":12,
+					"gameType":"MATCHED",
+					"bannedChampions":[],
+					"gameId":3000000001,
+					"observers":{"encryptionKey":"key"},
+					"gameQueueConfigId":450,
+					"participants":[{"bot":false,"spell2Id":4,"profileIconId":1,"puuid":"puuid-1","riotId":"Player#BR1","championId":22,"teamId":200,"spell1Id":32}],
+					"platformId":"BR1"
+				}],
+				"clientRefreshInterval":300
+			}`,
+			expectedResult: FeaturedGames{
+				GameList: []FeaturedGame{
+					{
+						GameMode:          "ARAM",
+						GameLength:        120,
+						MapID:             12,
+						GameType:          "MATCHED",
+						BannedChampions:   []BannedChampion{},
+						GameID:            3000000001,
+						Observers:         Observer{EncryptionKey: "key"},
+						GameQueueConfigID: 450,
+						Participants: []Participant{
+							{Spell2ID: 4, ProfileIconID: 1, PUUID: "puuid-1", RiotID: "Player#BR1", ChampionID: 22, TeamID: 200, Spell1ID: 32},
+						},
+						PlatformID: "BR1",
+					},
+				},
+				ClientRefreshInterval: 300,
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDoer := mock.NewDefaultDoer(tt.statusCode, tt.responseBody, tt.httpErr)
+			baseClient := internal.NewHttpClient(
+				mockDoer,
+				slog.Default(),
+				string(regions.PlatformBR1),
+				"apiKey",
+				internal.WithDefaultRetryPolicy(internal.NoRetry()),
+			)
+			pc := NewPlatformClient(baseClient)
+			resp, err := pc.GetFeaturedGames(context.Background())
+
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				if tt.wantRiotErr {
+					var rErr *internal.RiotError
+					assert.ErrorAs(t, err, &rErr)
+					assert.Equal(t, tt.statusCode, rErr.StatusCode)
+				}
+				return
+			}
+
+			require.Nil(t, err)
+			assert.Equal(t, tt.expectedResult, resp)
+		})
+	}
+}
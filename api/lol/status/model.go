@@ -0,0 +1,158 @@
+package status
+
+import (
+	"slices"
+	"strings"
+	"time"
+)
+
+type (
+	PlatformData struct {
+		ID           string   `json:"id"`
+		Name         string   `json:"name"`
+		Locales      []string `json:"locales"`
+		Maintenances []Status `json:"maintenances"`
+		Incidents    []Status `json:"incidents"`
+	}
+
+	// Status is a single incident or maintenance, only one of the severity or maintenance status is set.
+	Status struct {
+		ID                int               `json:"id"`
+		MaintenanceStatus MaintenanceStatus `json:"maintenance_status"`
+		IncidentSeverity  Severity          `json:"incident_severity"`
+		Titles            []Content         `json:"titles"`
+		Updates           []Update          `json:"updates"`
+		CreatedAt         time.Time         `json:"created_at"`
+		ArchiveAt         *time.Time        `json:"archive_at"`
+		UpdatedAt         *time.Time        `json:"updated_at"`
+		Platforms         []Platform        `json:"platforms"`
+	}
+
+	// Content is a translation of a title or update to the locale, like "en_US".
+	Content struct {
+		Locale  string `json:"locale"`
+		Content string `json:"content"`
+	}
+
+	Update struct {
+		ID               int               `json:"id"`
+		Author           string            `json:"author"`
+		Publish          bool              `json:"publish"`
+		PublishLocations []PublishLocation `json:"publish_locations"`
+		Translations     []Content         `json:"translations"`
+		CreatedAt        time.Time         `json:"created_at"`
+		UpdatedAt        time.Time         `json:"updated_at"`
+	}
+
+	MaintenanceStatus string
+	Severity          string
+	Platform          string
+	PublishLocation   string
+)
+
+const (
+	MaintenanceStatusScheduled  MaintenanceStatus = "scheduled"
+	MaintenanceStatusInProgress MaintenanceStatus = "in_progress"
+	MaintenanceStatusComplete   MaintenanceStatus = "complete"
+
+	SeverityInfo     Severity = "info"
+	SeverityWarning  Severity = "warning"
+	SeverityCritical Severity = "critical"
+
+	PlatformWindows Platform = "windows"
+	PlatformMacOS   Platform = "macos"
+	PlatformAndroid Platform = "android"
+	PlatformIOS     Platform = "ios"
+	PlatformPS4     Platform = "ps4"
+	PlatformXbone   Platform = "xbone"
+	PlatformSwitch  Platform = "switch"
+
+	PublishLocationRiotClient PublishLocation = "riotclient"
+	PublishLocationRiotStatus PublishLocation = "riotstatus"
+	PublishLocationGame       PublishLocation = "game"
+
+	// DefaultLocale is the locale used when the requested one isn't translated.
+	DefaultLocale = "en_US"
+)
+
+// severityRanks orders the severities, unknown severities rank as info.
+var severityRanks = map[Severity]int{
+	SeverityInfo:     0,
+	SeverityWarning:  1,
+	SeverityCritical: 2,
+}
+
+// AtLeast returns if the severity is the same or more severe than min.
+func (s Severity) AtLeast(minSeverity Severity) bool {
+	return severityRanks[s] >= severityRanks[minSeverity]
+}
+
+// Translation picks the content for the locale, like "pt_BR".
+// Falls back to the same language ("pt_PT"), then to the DefaultLocale and then to the first content.
+// Returns false only when there's no content at all.
+func Translation(contents []Content, locale string) (Content, bool) {
+	if len(contents) == 0 {
+		return Content{}, false
+	}
+
+	if i := slices.IndexFunc(contents, func(c Content) bool { return strings.EqualFold(c.Locale, locale) }); i >= 0 {
+		return contents[i], true
+	}
+
+	lang, _, _ := strings.Cut(locale, "_")
+	if i := slices.IndexFunc(contents, func(c Content) bool {
+		cLang, _, _ := strings.Cut(c.Locale, "_")
+		return strings.EqualFold(cLang, lang)
+	}); i >= 0 {
+		return contents[i], true
+	}
+
+	if i := slices.IndexFunc(contents, func(c Content) bool { return c.Locale == DefaultLocale }); i >= 0 {
+		return contents[i], true
+	}
+
+	return contents[0], true
+}
+
+// Title returns the title of the status translated to the locale, see Translation.
+func (s Status) Title(locale string) string {
+	c, _ := Translation(s.Titles, locale)
+	return c.Content
+}
+
+// Text returns the update translated to the locale, see Translation.
+func (u Update) Text(locale string) string {
+	c, _ := Translation(u.Translations, locale)
+	return c.Content
+}
+
+// Active returns if the status isn't archived at the given time.
+func (s Status) Active(now time.Time) bool {
+	return s.ArchiveAt == nil || now.Before(*s.ArchiveAt)
+}
+
+// Affects returns if the status affects any of the platforms.
+// A status without platforms affects all of them.
+func (s Status) Affects(platforms ...Platform) bool {
+	if len(s.Platforms) == 0 || len(platforms) == 0 {
+		return true
+	}
+
+	return slices.ContainsFunc(platforms, func(p Platform) bool {
+		return slices.Contains(s.Platforms, p)
+	})
+}
+
+// ActiveIncidents returns the incidents not archived yet, at least as severe as minSeverity.
+// When platforms are passed, only incidents affecting any of them are returned.
+func (pd PlatformData) ActiveIncidents(minSeverity Severity, platforms ...Platform) []Status {
+	now := time.Now()
+
+	var out []Status
+	for _, incident := range pd.Incidents {
+		if incident.Active(now) && incident.IncidentSeverity.AtLeast(minSeverity) && incident.Affects(platforms...) {
+			out = append(out, incident)
+		}
+	}
+	return out
+}
@@ -0,0 +1,75 @@
+package status
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTranslation(t *testing.T) {
+	contents := []Content{
+		{Locale: "en_US", Content: "english"},
+		{Locale: "pt_PT", Content: "portuguese"},
+		{Locale: "ko_KR", Content: "korean"},
+	}
+
+	tests := []struct {
+		name     string
+		contents []Content
+		locale   string
+		expected string
+		wantOk   bool
+	}{
+		{name: "exact locale", contents: contents, locale: "ko_KR", expected: "korean", wantOk: true},
+		{name: "case insensitive", contents: contents, locale: "KO_kr", expected: "korean", wantOk: true},
+		{name: "same language", contents: contents, locale: "pt_BR", expected: "portuguese", wantOk: true},
+		{name: "default locale", contents: contents, locale: "ja_JP", expected: "english", wantOk: true},
+		{name: "first content", contents: contents[1:], locale: "ja_JP", expected: "portuguese", wantOk: true},
+		{name: "no content", contents: nil, locale: "en_US", wantOk: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := Translation(tt.contents, tt.locale)
+			assert.Equal(t, tt.wantOk, ok)
+			assert.Equal(t, tt.expected, got.Content)
+		})
+	}
+}
+
+func TestActiveIncidents(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+
+	pd := PlatformData{
+		Incidents: []Status{
+			{ID: 1, IncidentSeverity: SeverityInfo},
+			{ID: 2, IncidentSeverity: SeverityWarning, ArchiveAt: &future, Platforms: []Platform{PlatformWindows}},
+			{ID: 3, IncidentSeverity: SeverityCritical, Platforms: []Platform{PlatformMacOS}},
+			{ID: 4, IncidentSeverity: SeverityCritical, ArchiveAt: &past},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		minSeverity Severity
+		platforms   []Platform
+		expectedIDs []int
+	}{
+		{name: "all active", minSeverity: SeverityInfo, expectedIDs: []int{1, 2, 3}},
+		{name: "minimum severity", minSeverity: SeverityWarning, expectedIDs: []int{2, 3}},
+		{name: "platform filter", minSeverity: SeverityInfo, platforms: []Platform{PlatformWindows}, expectedIDs: []int{1, 2}},
+		{name: "severity and platform", minSeverity: SeverityCritical, platforms: []Platform{PlatformWindows}, expectedIDs: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ids []int
+			for _, incident := range pd.ActiveIncidents(tt.minSeverity, tt.platforms...) {
+				ids = append(ids, incident.ID)
+			}
+			assert.Equal(t, tt.expectedIDs, ids)
+		})
+	}
+}
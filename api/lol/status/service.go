@@ -0,0 +1,31 @@
+package status
+
+import (
+	"context"
+	"leago/internal"
+	"leago/options"
+)
+
+const (
+	MethodGetPlatformData = "Status.GetPlatformData"
+)
+
+// GetPlatformData returns the current incidents and maintenances of the platform.
+func (pc *PlatformClient) GetPlatformData(
+	ctx context.Context,
+	opts ...options.PublicOption,
+) (PlatformData, error) {
+	endpoint := "/lol/status/v4/platform-data"
+
+	defaultOpts := []internal.RequestOption{
+		internal.WithApiMethod(MethodGetPlatformData),
+	}
+
+	uri := pc.client.GetURL(endpoint)
+	return internal.AuthRequest[PlatformData](
+		ctx,
+		pc.client,
+		uri,
+		options.MergeOptions(defaultOpts, opts)...,
+	)
+}
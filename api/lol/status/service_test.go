@@ -0,0 +1,123 @@
+package status
+
+import (
+	"context"
+	"leago/internal"
+	"leago/internal/mock"
+	"leago/regions"
+	"log/slog"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPlatformData(t *testing.T) {
+	archiveAt := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		statusCode     int
+		httpErr        error
+		responseBody   string
+		expectedResult PlatformData
+		wantErr        bool
+		wantRiotErr    bool
+	}{
+		{
+			name:         "riot error",
+			statusCode:   http.StatusUnauthorized,
+			responseBody: `{"status":{"status_code":401}}`,
+			wantErr:      true,
+			wantRiotErr:  true,
+		},
+		{
+			name:         "unmatched json",
+			statusCode:   http.StatusOK,
+			responseBody: `{"incidents":{"id":1}}`,
+			wantErr:      true,
+			wantRiotErr:  false,
+		},
+		{
+			name:       "success",
+			statusCode: http.StatusOK,
+			responseBody: `{
+				"id":"BR1",
+				"name":"Brazil",
+				"locales":["pt_BR","en_US"],
+				"maintenances":[],
+				"incidents":[{
+					"id":1,
+					"maintenance_status":null,
+					"incident_severity":"warning",
+					"titles":[{"locale":"en_US","content":"Ranked disabled"}],
+					"updates":[{
+						"id":10,
+						"author":"Riot",
+						"publish":true,
+						"publish_locations":["riotclient","game"],
+						"translations":[{"locale":"en_US","content":"Investigating"}],
+						"created_at":"2024-01-01T10:00:00Z",
+						"updated_at":"2024-01-01T10:05:00Z"
+					}],
+					"created_at":"2024-01-01T10:00:00Z",
+					"archive_at":"2024-01-02T00:00:00Z",
+					"updated_at":null,
+					"platforms":["windows","macos"]
+				}]
+			}`,
+			expectedResult: PlatformData{
+				ID:           "BR1",
+				Name:         "Brazil",
+				Locales:      []string{"pt_BR", "en_US"},
+				Maintenances: []Status{},
+				Incidents: []Status{
+					{
+						ID:               1,
+						IncidentSeverity: SeverityWarning,
+						Titles:           []Content{{Locale: "en_US", Content: "Ranked disabled"}},
+						Updates: []Update{
+							{
+								ID:               10,
+								Author:           "Riot",
+								Publish:          true,
+								PublishLocations: []PublishLocation{PublishLocationRiotClient, PublishLocationGame},
+								Translations:     []Content{{Locale: "en_US", Content: "Investigating"}},
+								CreatedAt:        time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC),
+								UpdatedAt:        time.Date(2024, 1, 1, 10, 5, 0, 0, time.UTC),
+							},
+						},
+						CreatedAt: time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC),
+						ArchiveAt: &archiveAt,
+						Platforms: []Platform{PlatformWindows, PlatformMacOS},
+					},
+				},
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDoer := mock.NewDefaultDoer(tt.statusCode, tt.responseBody, tt.httpErr)
+			baseClient := internal.NewHttpClient(mockDoer, slog.Default(), string(regions.PlatformBR1), "apiKey")
+			pc := NewPlatformClient(baseClient)
+			resp, err := pc.GetPlatformData(context.Background())
+
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				if tt.wantRiotErr {
+					var rErr *internal.RiotError
+					assert.ErrorAs(t, err, &rErr)
+					assert.Equal(t, tt.statusCode, rErr.StatusCode)
+				}
+				return
+			}
+
+			require.Nil(t, err)
+			assert.Equal(t, tt.expectedResult, resp)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package summoner
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type Summoner struct {
+	AccountID     string    `json:"accountId"`
+	ProfileIconID int       `json:"profileIconId"`
+	RevisionDate  time.Time `json:"revisionDate"`
+	PUUID         string    `json:"puuid"`
+	SummonerLevel int64     `json:"summonerLevel"`
+}
+
+// UnmarshalJSON decodes the revisionDate, sent as epoch milliseconds, as time.
+func (s *Summoner) UnmarshalJSON(data []byte) error {
+	type alias Summoner
+	aux := struct {
+		*alias
+		RevisionDate int64 `json:"revisionDate"`
+	}{
+		alias: (*alias)(s),
+	}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	s.RevisionDate = time.Time{}
+	if aux.RevisionDate != 0 {
+		s.RevisionDate = time.UnixMilli(aux.RevisionDate)
+	}
+
+	return nil
+}
+
+// MarshalJSON encodes the revisionDate as epoch milliseconds, like Riot sends it, so it can be decoded again.
+func (s Summoner) MarshalJSON() ([]byte, error) {
+	type alias Summoner
+	var revisionDate int64
+	if !s.RevisionDate.IsZero() {
+		revisionDate = s.RevisionDate.UnixMilli()
+	}
+
+	return json.Marshal(struct {
+		alias
+		RevisionDate int64 `json:"revisionDate"`
+	}{
+		alias:        alias(s),
+		RevisionDate: revisionDate,
+	})
+}
@@ -0,0 +1,52 @@
+package summoner
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSummonerJSONRoundTrip(t *testing.T) {
+	tests := []struct {
+		name     string
+		summoner Summoner
+		wantDate string
+	}{
+		{
+			name: "revision date",
+			summoner: Summoner{
+				AccountID:     "accountId",
+				ProfileIconID: 10,
+				RevisionDate:  time.UnixMilli(1700000000123),
+				PUUID:         "puuid",
+				SummonerLevel: 300,
+			},
+			wantDate: `1700000000123`,
+		},
+		{
+			name:     "zero revision date",
+			summoner: Summoner{PUUID: "puuid"},
+			wantDate: `0`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.summoner)
+			require.NoError(t, err)
+
+			var raw map[string]json.RawMessage
+			require.NoError(t, json.Unmarshal(data, &raw))
+			assert.JSONEq(t, tt.wantDate, string(raw["revisionDate"]))
+
+			var got Summoner
+			require.NoError(t, json.Unmarshal(data, &got))
+			assert.True(t, tt.summoner.RevisionDate.Equal(got.RevisionDate))
+			got.RevisionDate = tt.summoner.RevisionDate
+			assert.Equal(t, tt.summoner, got)
+		})
+	}
+}
@@ -0,0 +1,209 @@
+package summoner
+
+import (
+	"context"
+	"leago/internal"
+	"leago/internal/mock"
+	"leago/regions"
+	"log/slog"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	expectedSummoner = Summoner{
+		AccountID:     "test-account",
+		ProfileIconID: 4568,
+		RevisionDate:  time.UnixMilli(1700000000000),
+		PUUID:         "test-puuid",
+		SummonerLevel: 350,
+	}
+
+	summonerJSON = `{
+		"accountId":"test-account",
+		"profileIconId":4568,
+		"revisionDate":1700000000000,
+		"puuid":"test-puuid",
+		"summonerLevel":350
+	}`
+)
+
+func TestGetByPUUID(t *testing.T) {
+	tests := []struct {
+		name           string
+		statusCode     int
+		puuid          string
+		httpErr        error
+		responseBody   string
+		expectedResult Summoner
+		wantErr        bool
+		wantRiotErr    bool
+	}{
+		{
+			name:         "riot error",
+			puuid:        "test-puuid",
+			statusCode:   http.StatusNotFound,
+			responseBody: `{"status":{"status_code":404}}`,
+			wantErr:      true,
+			wantRiotErr:  true,
+		},
+		{
+			name:         "unmatched json",
+			puuid:        "test-puuid",
+			statusCode:   http.StatusOK,
+			responseBody: `{"revisionDate":"yesterday"}`,
+			wantErr:      true,
+			wantRiotErr:  false,
+		},
+		{
+			name:           "success",
+			puuid:          "test-puuid",
+			statusCode:     http.StatusOK,
+			responseBody:   summonerJSON,
+			expectedResult: expectedSummoner,
+			wantErr:        false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDoer := mock.NewDefaultDoer(tt.statusCode, tt.responseBody, tt.httpErr)
+			baseClient := internal.NewHttpClient(mockDoer, slog.Default(), string(regions.PlatformBR1), "apiKey")
+			pc := NewPlatformClient(baseClient)
+			resp, err := pc.GetByPUUID(context.Background(), tt.puuid)
+
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				if tt.wantRiotErr {
+					var rErr *internal.RiotError
+					assert.ErrorAs(t, err, &rErr)
+					assert.Equal(t, tt.statusCode, rErr.StatusCode)
+				}
+				return
+			}
+
+			require.Nil(t, err)
+			assert.Equal(t, "/lol/summoner/v4/summoners/by-puuid/test-puuid", mockDoer.CapturedReq.URL.Path)
+			assert.Equal(t, tt.expectedResult, resp)
+		})
+	}
+}
+
+func TestGetByAccountID(t *testing.T) {
+	tests := []struct {
+		name           string
+		statusCode     int
+		accountID      string
+		httpErr        error
+		responseBody   string
+		expectedResult Summoner
+		wantErr        bool
+		wantRiotErr    bool
+	}{
+		{
+			name:         "riot error",
+			accountID:    "test-account",
+			statusCode:   http.StatusBadRequest,
+			responseBody: `{"status":{"status_code":400}}`,
+			wantErr:      true,
+			wantRiotErr:  true,
+		},
+		{
+			name:         "invalid json",
+			accountID:    "test-account",
+			statusCode:   http.StatusOK,
+			responseBody: `{"accountId":`,
+			wantErr:      true,
+			wantRiotErr:  false,
+		},
+		{
+			name:           "success",
+			accountID:      "test-account",
+			statusCode:     http.StatusOK,
+			responseBody:   summonerJSON,
+			expectedResult: expectedSummoner,
+			wantErr:        false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDoer := mock.NewDefaultDoer(tt.statusCode, tt.responseBody, tt.httpErr)
+			baseClient := internal.NewHttpClient(mockDoer, slog.Default(), string(regions.PlatformBR1), "apiKey")
+			pc := NewPlatformClient(baseClient)
+			resp, err := pc.GetByAccountID(context.Background(), tt.accountID)
+
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				if tt.wantRiotErr {
+					var rErr *internal.RiotError
+					assert.ErrorAs(t, err, &rErr)
+					assert.Equal(t, tt.statusCode, rErr.StatusCode)
+				}
+				return
+			}
+
+			require.Nil(t, err)
+			assert.Equal(t, "/lol/summoner/v4/summoners/by-account/test-account", mockDoer.CapturedReq.URL.Path)
+			assert.Equal(t, tt.expectedResult, resp)
+		})
+	}
+}
+
+func TestGetByAccessToken(t *testing.T) {
+	tests := []struct {
+		name           string
+		statusCode     int
+		accessToken    string
+		httpErr        error
+		responseBody   string
+		expectedResult Summoner
+		wantErr        bool
+		wantRiotErr    bool
+	}{
+		{
+			name:         "riot error",
+			accessToken:  "expired-token",
+			statusCode:   http.StatusUnauthorized,
+			responseBody: `{"status":{"status_code":401}}`,
+			wantErr:      true,
+			wantRiotErr:  true,
+		},
+		{
+			name:           "success",
+			accessToken:    "access-token",
+			statusCode:     http.StatusOK,
+			responseBody:   summonerJSON,
+			expectedResult: expectedSummoner,
+			wantErr:        false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDoer := mock.NewDefaultDoer(tt.statusCode, tt.responseBody, tt.httpErr)
+			baseClient := internal.NewHttpClient(mockDoer, slog.Default(), string(regions.PlatformBR1), "apiKey")
+			pc := NewPlatformClient(baseClient)
+			resp, err := pc.GetByAccessToken(context.Background(), tt.accessToken)
+
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				if tt.wantRiotErr {
+					var rErr *internal.RiotError
+					assert.ErrorAs(t, err, &rErr)
+					assert.Equal(t, tt.statusCode, rErr.StatusCode)
+				}
+				return
+			}
+
+			require.Nil(t, err)
+			assert.Equal(t, "/lol/summoner/v4/summoners/me", mockDoer.CapturedReq.URL.Path)
+			assert.Equal(t, "Bearer "+tt.accessToken, mockDoer.CapturedReq.Header.Get("Authorization"))
+			assert.Equal(t, tt.expectedResult, resp)
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package tournament
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"leago/api/lol/match"
+	"leago/options"
+	"log/slog"
+	"net/http"
+	"strconv"
+)
+
+type (
+	// Callback is the game result sent by Riot to the provider URL when a tournament game ends.
+	Callback struct {
+		StartTime int64  `json:"startTime"`
+		ShortCode string `json:"shortCode"`
+		// MetaData is the metadata set when the tournament code was created, usually JSON.
+		MetaData    string   `json:"metaData"`
+		GameID      int64    `json:"gameId"`
+		GameName    string   `json:"gameName"`
+		GameType    string   `json:"gameType"`
+		GameMap     int      `json:"gameMap"`
+		GameMode    string   `json:"gameMode"`
+		Region      string   `json:"region"`
+		WinningTeam []Player `json:"winningTeam"`
+		LosingTeam  []Player `json:"losingTeam"`
+	}
+
+	// CallbackEvent is delivered for each valid callback received.
+	// Match is only set when the handler has a match fetcher and the match was found.
+	CallbackEvent struct {
+		Callback Callback
+		Match    *match.Match
+		MatchErr error
+	}
+
+	// CallbackFunc handles a callback event, returning an error answers Riot with a 500 so the callback is retried.
+	CallbackFunc func(ctx context.Context, event CallbackEvent) error
+
+	// MatchFetcher gets the full match of a callback, implemented by the match region client.
+	MatchFetcher interface {
+		GetMatch(ctx context.Context, matchID string, opts ...options.PublicOption) (match.Match, error)
+	}
+
+	// CallbackHandler is a http.Handler receiving the tournament game results.
+	CallbackHandler struct {
+		handle       CallbackFunc
+		matches      MatchFetcher
+		logger       *slog.Logger
+		maxBodyBytes int64
+	}
+
+	CallbackOption func(*CallbackHandler)
+)
+
+const defaultMaxCallbackBytes = 1 << 20
+
+var errInvalidCallback = errors.New("invalid tournament callback")
+
+// NewCallbackHandler returns a handler calling handle for every valid callback.
+func NewCallbackHandler(handle CallbackFunc, opts ...CallbackOption) *CallbackHandler {
+	h := &CallbackHandler{
+		handle:       handle,
+		logger:       slog.New(slog.DiscardHandler),
+		maxBodyBytes: defaultMaxCallbackBytes,
+	}
+
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	return h
+}
+
+// WithMatchFetcher fetches the full match of each callback before handling it, like lol.RegionClient.Match.
+// Failing to fetch the match doesn't fail the callback, the error is set on the event instead.
+func WithMatchFetcher(matches MatchFetcher) CallbackOption {
+	return func(h *CallbackHandler) {
+		h.matches = matches
+	}
+}
+
+// WithCallbackLogger sets the logger used for rejected callbacks.
+func WithCallbackLogger(logger *slog.Logger) CallbackOption {
+	return func(h *CallbackHandler) {
+		h.logger = logger
+	}
+}
+
+// WithMaxBodyBytes limits the callback body size (Default 1MB).
+func WithMaxBodyBytes(n int64) CallbackOption {
+	return func(h *CallbackHandler) {
+		h.maxBodyBytes = n
+	}
+}
+
+// ChannelCallback returns a CallbackFunc delivering the events to the channel.
+// Gives up when the request is cancelled before the event is received, so Riot retries it.
+func ChannelCallback(ch chan<- CallbackEvent) CallbackFunc {
+	return func(ctx context.Context, event CallbackEvent) error {
+		select {
+		case ch <- event:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// MatchID returns the Match-V5 ID of the game, like "BR1_3000000000".
+func (c Callback) MatchID() string {
+	return c.Region + "_" + strconv.FormatInt(c.GameID, 10)
+}
+
+func (h *CallbackHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	cb, err := h.decode(w, r)
+	if err != nil {
+		h.logger.Warn("rejected tournament callback", "error", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	event := CallbackEvent{Callback: cb}
+	if h.matches != nil {
+		m, err := h.matches.GetMatch(r.Context(), cb.MatchID())
+		if err != nil {
+			event.MatchErr = err
+		} else {
+			event.Match = &m
+		}
+	}
+
+	if err := h.handle(r.Context(), event); err != nil {
+		h.logger.Error("failed to handle tournament callback", "shortCode", cb.ShortCode, "error", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// decode reads and validates the callback body.
+func (h *CallbackHandler) decode(w http.ResponseWriter, r *http.Request) (Callback, error) {
+	var cb Callback
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, h.maxBodyBytes)).Decode(&cb); err != nil {
+		return cb, fmt.Errorf("%w: %w", errInvalidCallback, err)
+	}
+
+	if cb.ShortCode == "" {
+		return cb, fmt.Errorf("%w: missing shortCode", errInvalidCallback)
+	}
+
+	if cb.GameID <= 0 || cb.Region == "" {
+		return cb, fmt.Errorf("%w: missing gameId or region", errInvalidCallback)
+	}
+
+	return cb, nil
+}
@@ -0,0 +1,202 @@
+package tournament
+
+import (
+	"context"
+	"errors"
+	"leago/api/lol/match"
+	"leago/options"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubMatches struct {
+	gotID string
+	err   error
+}
+
+const callbackJSON = `{
+	"startTime":1700000000000,
+	"shortCode":"BR0001-CODE-1",
+	"metaData":"{\"round\":1}",
+	"gameId":3000000000,
+	"gameName":"game",
+	"gameType":"Practice",
+	"gameMap":11,
+	"gameMode":"CLASSIC",
+	"region":"BR1",
+	"winningTeam":[{"puuid":"puuid-1"}],
+	"losingTeam":[{"puuid":"puuid-2"}]
+}`
+
+func (s *stubMatches) GetMatch(_ context.Context, matchID string, _ ...options.PublicOption) (match.Match, error) {
+	s.gotID = matchID
+	if s.err != nil {
+		return match.Match{}, s.err
+	}
+	return match.Match{Metadata: match.Metadata{MatchID: matchID}}, nil
+}
+
+func TestCallbackHandler(t *testing.T) {
+	expectedCallback := Callback{
+		StartTime:   1700000000000,
+		ShortCode:   "BR0001-CODE-1",
+		MetaData:    `{"round":1}`,
+		GameID:      3000000000,
+		GameName:    "game",
+		GameType:    "Practice",
+		GameMap:     11,
+		GameMode:    "CLASSIC",
+		Region:      "BR1",
+		WinningTeam: []Player{{PUUID: "puuid-1"}},
+		LosingTeam:  []Player{{PUUID: "puuid-2"}},
+	}
+
+	tests := []struct {
+		name         string
+		method       string
+		body         string
+		handleErr    error
+		matches      *stubMatches
+		opts         []CallbackOption
+		wantStatus   int
+		wantCalled   bool
+		wantMatch    bool
+		wantMatchErr bool
+	}{
+		{
+			name:       "method not allowed",
+			method:     http.MethodGet,
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+		{
+			name:       "malformed body",
+			method:     http.MethodPost,
+			body:       `{"shortCode":`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "missing short code",
+			method:     http.MethodPost,
+			body:       `{"gameId":3000000000,"region":"BR1"}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "missing game id",
+			method:     http.MethodPost,
+			body:       `{"shortCode":"BR0001-CODE-1","region":"BR1"}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "body too large",
+			method:     http.MethodPost,
+			body:       callbackJSON,
+			opts:       []CallbackOption{WithMaxBodyBytes(16)},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "success",
+			method:     http.MethodPost,
+			body:       callbackJSON,
+			wantStatus: http.StatusOK,
+			wantCalled: true,
+		},
+		{
+			name:       "handler error",
+			method:     http.MethodPost,
+			body:       callbackJSON,
+			handleErr:  errors.New("database down"),
+			wantStatus: http.StatusInternalServerError,
+			wantCalled: true,
+		},
+		{
+			name:       "match fetched",
+			method:     http.MethodPost,
+			body:       callbackJSON,
+			matches:    &stubMatches{},
+			wantStatus: http.StatusOK,
+			wantCalled: true,
+			wantMatch:  true,
+		},
+		{
+			name:         "match fetch failure is delivered",
+			method:       http.MethodPost,
+			body:         callbackJSON,
+			matches:      &stubMatches{err: errors.New("not found")},
+			wantStatus:   http.StatusOK,
+			wantCalled:   true,
+			wantMatchErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				called bool
+				got    CallbackEvent
+			)
+			handle := func(_ context.Context, event CallbackEvent) error {
+				called = true
+				got = event
+				return tt.handleErr
+			}
+
+			opts := tt.opts
+			if tt.matches != nil {
+				opts = append(opts, WithMatchFetcher(tt.matches))
+			}
+
+			req := httptest.NewRequest(tt.method, "/callback", strings.NewReader(tt.body))
+			rec := httptest.NewRecorder()
+			NewCallbackHandler(handle, opts...).ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			assert.Equal(t, tt.wantCalled, called)
+			if !tt.wantCalled {
+				return
+			}
+
+			assert.Equal(t, expectedCallback, got.Callback)
+			if tt.matches != nil {
+				assert.Equal(t, "BR1_3000000000", tt.matches.gotID)
+			}
+
+			if tt.wantMatch {
+				require.NotNil(t, got.Match)
+				assert.Equal(t, "BR1_3000000000", got.Match.Metadata.MatchID)
+			} else {
+				assert.Nil(t, got.Match)
+			}
+			assert.Equal(t, tt.wantMatchErr, got.MatchErr != nil)
+		})
+	}
+}
+
+func TestChannelCallback(t *testing.T) {
+	ch := make(chan CallbackEvent, 1)
+	server := httptest.NewServer(NewCallbackHandler(ChannelCallback(ch)))
+	defer server.Close()
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, server.URL, strings.NewReader(callbackJSON))
+	require.Nil(t, err)
+
+	resp, err := server.Client().Do(req)
+	require.Nil(t, err)
+	_ = resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	event := <-ch
+	assert.Equal(t, "BR0001-CODE-1", event.Callback.ShortCode)
+}
+
+func TestChannelCallbackCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := ChannelCallback(make(chan CallbackEvent))(ctx, CallbackEvent{})
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,130 @@
+package tournament
+
+import (
+	"context"
+	"leago/options"
+)
+
+type (
+	// API are the endpoints shared by the Tournament-V5 and Tournament-Stub-V5 clients.
+	// Code written against it can run on the stub during development and on the real API in production.
+	API interface {
+		RegisterProvider(ctx context.Context, params ProviderRegistrationParameters, opts ...options.PublicOption) (int, error)
+		RegisterTournament(ctx context.Context, params TournamentRegistrationParameters, opts ...options.PublicOption) (int, error)
+		CreateCodes(ctx context.Context, tournamentID, count int, params CodeParameters, opts ...options.PublicOption) ([]string, error)
+		GetCode(ctx context.Context, tournamentCode string, opts ...options.PublicOption) (Code, error)
+		GetLobbyEventsByCode(ctx context.Context, tournamentCode string, opts ...options.PublicOption) (LobbyEvents, error)
+	}
+
+	ProviderRegistrationParameters struct {
+		Region ProviderRegion `json:"region"`
+		// URL receives the game results callbacks, must use port 80 for http or 443 for https.
+		URL string `json:"url"`
+	}
+
+	TournamentRegistrationParameters struct {
+		ProviderID int    `json:"providerId"`
+		Name       string `json:"name,omitempty"`
+	}
+
+	CodeParameters struct {
+		// AllowedParticipants are the puuids allowed to join the lobby, empty allows everyone.
+		AllowedParticipants []string      `json:"allowedParticipants,omitempty"`
+		Metadata            string        `json:"metadata,omitempty"`
+		TeamSize            int           `json:"teamSize"`
+		PickType            PickType      `json:"pickType"`
+		MapType             MapType       `json:"mapType"`
+		SpectatorType       SpectatorType `json:"spectatorType"`
+		// EnoughPlayers allows the game to start with less than the full team size.
+		EnoughPlayers bool `json:"enoughPlayers"`
+	}
+
+	CodeUpdateParameters struct {
+		AllowedParticipants []string      `json:"allowedParticipants,omitempty"`
+		PickType            PickType      `json:"pickType"`
+		MapType             MapType       `json:"mapType"`
+		SpectatorType       SpectatorType `json:"spectatorType"`
+	}
+
+	Code struct {
+		Code         string        `json:"code"`
+		Spectators   SpectatorType `json:"spectators"`
+		LobbyName    string        `json:"lobbyName"`
+		MetaData     string        `json:"metaData"`
+		Password     string        `json:"password"`
+		TeamSize     int           `json:"teamSize"`
+		ProviderID   int           `json:"providerId"`
+		PickType     PickType      `json:"pickType"`
+		TournamentID int           `json:"tournamentId"`
+		ID           int           `json:"id"`
+		Region       string        `json:"region"`
+		Map          MapType       `json:"map"`
+		Participants []string      `json:"participants"`
+	}
+
+	Game struct {
+		WinningTeam []Player `json:"winningTeam"`
+		LosingTeam  []Player `json:"losingTeam"`
+		ShortCode   string   `json:"shortCode"`
+		MetaData    string   `json:"metaData"`
+		GameID      int64    `json:"gameId"`
+		GameName    string   `json:"gameName"`
+		GameType    string   `json:"gameType"`
+		GameMap     int      `json:"gameMap"`
+		GameMode    string   `json:"gameMode"`
+		Region      string   `json:"region"`
+	}
+
+	Player struct {
+		PUUID string `json:"puuid"`
+	}
+
+	LobbyEvents struct {
+		EventList []LobbyEvent `json:"eventList"`
+	}
+
+	LobbyEvent struct {
+		// Timestamp is the epoch milliseconds of the event, sent as a string.
+		Timestamp string `json:"timestamp"`
+		EventType string `json:"eventType"`
+		PUUID     string `json:"puuid"`
+	}
+
+	PickType       string
+	MapType        string
+	SpectatorType  string
+	ProviderRegion string
+)
+
+const (
+	PickTypeBlindPick       PickType = "BLIND_PICK"
+	PickTypeDraftMode       PickType = "DRAFT_MODE"
+	PickTypeAllRandom       PickType = "ALL_RANDOM"
+	PickTypeTournamentDraft PickType = "TOURNAMENT_DRAFT"
+
+	MapTypeSummonersRift MapType = "SUMMONERS_RIFT"
+	MapTypeHowlingAbyss  MapType = "HOWLING_ABYSS"
+
+	SpectatorTypeNone      SpectatorType = "NONE"
+	SpectatorTypeLobbyOnly SpectatorType = "LOBBYONLY"
+	SpectatorTypeAll       SpectatorType = "ALL"
+
+	ProviderRegionBR   ProviderRegion = "BR"
+	ProviderRegionEUNE ProviderRegion = "EUNE"
+	ProviderRegionEUW  ProviderRegion = "EUW"
+	ProviderRegionJP   ProviderRegion = "JP"
+	ProviderRegionLAN  ProviderRegion = "LAN"
+	ProviderRegionLAS  ProviderRegion = "LAS"
+	ProviderRegionNA   ProviderRegion = "NA"
+	ProviderRegionOCE  ProviderRegion = "OCE"
+	ProviderRegionPBE  ProviderRegion = "PBE"
+	ProviderRegionRU   ProviderRegion = "RU"
+	ProviderRegionTR   ProviderRegion = "TR"
+	ProviderRegionKR   ProviderRegion = "KR"
+	ProviderRegionPH   ProviderRegion = "PH"
+	ProviderRegionSG   ProviderRegion = "SG"
+	ProviderRegionTH   ProviderRegion = "TH"
+	ProviderRegionTW   ProviderRegion = "TW"
+	ProviderRegionVN   ProviderRegion = "VN"
+	ProviderRegionME   ProviderRegion = "ME"
+)
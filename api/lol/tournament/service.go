@@ -0,0 +1,197 @@
+package tournament
+
+import (
+	"context"
+	"fmt"
+	"leago/internal"
+	"leago/options"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+const (
+	MethodRegisterProvider     = "Tournament.RegisterProvider"
+	MethodRegisterTournament   = "Tournament.RegisterTournament"
+	MethodCreateCodes          = "Tournament.CreateCodes"
+	MethodGetCode              = "Tournament.GetCode"
+	MethodUpdateCode           = "Tournament.UpdateCode"
+	MethodGetGamesByCode       = "Tournament.GetGamesByCode"
+	MethodGetLobbyEventsByCode = "Tournament.GetLobbyEventsByCode"
+)
+
+// Ensures the client can be used through the shared API.
+var _ API = (*RegionClient)(nil)
+
+// RegisterProvider registers the provider callback URL and returns the provider ID.
+func (rc *RegionClient) RegisterProvider(
+	ctx context.Context,
+	params ProviderRegistrationParameters,
+	opts ...options.PublicOption,
+) (int, error) {
+	endpoint := "/lol/tournament/v5/providers"
+
+	defaultOpts := []internal.RequestOption{
+		internal.WithApiMethod(MethodRegisterProvider),
+		internal.WithHttpMethod(http.MethodPost),
+		internal.WithBody(params),
+	}
+
+	uri := rc.client.GetURL(endpoint)
+	return internal.AuthRequest[int](
+		ctx,
+		rc.client,
+		uri,
+		options.MergeOptions(defaultOpts, opts)...,
+	)
+}
+
+// RegisterTournament creates a tournament for the provider and returns the tournament ID.
+func (rc *RegionClient) RegisterTournament(
+	ctx context.Context,
+	params TournamentRegistrationParameters,
+	opts ...options.PublicOption,
+) (int, error) {
+	endpoint := "/lol/tournament/v5/tournaments"
+
+	defaultOpts := []internal.RequestOption{
+		internal.WithApiMethod(MethodRegisterTournament),
+		internal.WithHttpMethod(http.MethodPost),
+		internal.WithBody(params),
+	}
+
+	uri := rc.client.GetURL(endpoint)
+	return internal.AuthRequest[int](
+		ctx,
+		rc.client,
+		uri,
+		options.MergeOptions(defaultOpts, opts)...,
+	)
+}
+
+// CreateCodes generates count tournament codes, between 1 and 1000, for the tournament.
+func (rc *RegionClient) CreateCodes(
+	ctx context.Context,
+	tournamentID int,
+	count int,
+	params CodeParameters,
+	opts ...options.PublicOption,
+) ([]string, error) {
+	endpoint := "/lol/tournament/v5/codes"
+
+	defaultOpts := []internal.RequestOption{
+		internal.WithApiMethod(MethodCreateCodes),
+		internal.WithHttpMethod(http.MethodPost),
+		internal.WithBody(params),
+		internal.WithParam("tournamentId", strconv.Itoa(tournamentID)),
+		internal.WithParam("count", strconv.Itoa(count)),
+	}
+
+	uri := rc.client.GetURL(endpoint)
+	return internal.AuthRequest[[]string](
+		ctx,
+		rc.client,
+		uri,
+		options.MergeOptions(defaultOpts, opts)...,
+	)
+}
+
+// GetCode returns the tournament code details.
+func (rc *RegionClient) GetCode(
+	ctx context.Context,
+	tournamentCode string,
+	opts ...options.PublicOption,
+) (Code, error) {
+	endpoint := fmt.Sprintf(
+		"/lol/tournament/v5/codes/%s",
+		url.PathEscape(tournamentCode),
+	)
+
+	defaultOpts := []internal.RequestOption{
+		internal.WithApiMethod(MethodGetCode),
+	}
+
+	uri := rc.client.GetURL(endpoint)
+	return internal.AuthRequest[Code](
+		ctx,
+		rc.client,
+		uri,
+		options.MergeOptions(defaultOpts, opts)...,
+	)
+}
+
+// UpdateCode updates the pick type, map, spectator type and allowed participants of the tournament code.
+func (rc *RegionClient) UpdateCode(
+	ctx context.Context,
+	tournamentCode string,
+	params CodeUpdateParameters,
+	opts ...options.PublicOption,
+) error {
+	endpoint := fmt.Sprintf(
+		"/lol/tournament/v5/codes/%s",
+		url.PathEscape(tournamentCode),
+	)
+
+	defaultOpts := []internal.RequestOption{
+		internal.WithApiMethod(MethodUpdateCode),
+		internal.WithHttpMethod(http.MethodPut),
+		internal.WithBody(params),
+	}
+
+	uri := rc.client.GetURL(endpoint)
+	_, err := internal.AuthRequest[internal.NoContent](
+		ctx,
+		rc.client,
+		uri,
+		options.MergeOptions(defaultOpts, opts)...,
+	)
+	return err
+}
+
+// GetGamesByCode returns the results of the games played with the tournament code.
+func (rc *RegionClient) GetGamesByCode(
+	ctx context.Context,
+	tournamentCode string,
+	opts ...options.PublicOption,
+) ([]Game, error) {
+	endpoint := fmt.Sprintf(
+		"/lol/tournament/v5/games/by-code/%s",
+		url.PathEscape(tournamentCode),
+	)
+
+	defaultOpts := []internal.RequestOption{
+		internal.WithApiMethod(MethodGetGamesByCode),
+	}
+
+	uri := rc.client.GetURL(endpoint)
+	return internal.AuthRequest[[]Game](
+		ctx,
+		rc.client,
+		uri,
+		options.MergeOptions(defaultOpts, opts)...,
+	)
+}
+
+// GetLobbyEventsByCode returns the lobby events, like players joining and champion select starting, of the tournament code.
+func (rc *RegionClient) GetLobbyEventsByCode(
+	ctx context.Context,
+	tournamentCode string,
+	opts ...options.PublicOption,
+) (LobbyEvents, error) {
+	endpoint := fmt.Sprintf(
+		"/lol/tournament/v5/lobby-events/by-code/%s",
+		url.PathEscape(tournamentCode),
+	)
+
+	defaultOpts := []internal.RequestOption{
+		internal.WithApiMethod(MethodGetLobbyEventsByCode),
+	}
+
+	uri := rc.client.GetURL(endpoint)
+	return internal.AuthRequest[LobbyEvents](
+		ctx,
+		rc.client,
+		uri,
+		options.MergeOptions(defaultOpts, opts)...,
+	)
+}
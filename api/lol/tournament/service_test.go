@@ -0,0 +1,502 @@
+package tournament
+
+import (
+	"context"
+	"io"
+	"leago/internal"
+	"leago/internal/mock"
+	"leago/regions"
+	"log/slog"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRegionClient(doer internal.Doer) *RegionClient {
+	baseClient := internal.NewHttpClient(
+		doer,
+		slog.Default(),
+		string(regions.RegionAmericas),
+		"apiKey",
+		internal.WithDefaultRetryPolicy(internal.NoRetry()),
+	)
+	return NewRegionClient(baseClient)
+}
+
+func TestRegisterProvider(t *testing.T) {
+	tests := []struct {
+		name           string
+		statusCode     int
+		httpErr        error
+		params         ProviderRegistrationParameters
+		responseBody   string
+		expectedResult int
+		wantErr        bool
+		wantRiotErr    bool
+	}{
+		{
+			name:         "riot error",
+			params:       ProviderRegistrationParameters{Region: ProviderRegionBR, URL: "http://example.com"},
+			statusCode:   http.StatusBadRequest,
+			responseBody: `{"status":{"status_code":400}}`,
+			wantErr:      true,
+			wantRiotErr:  true,
+		},
+		{
+			name:         "unmatched json",
+			params:       ProviderRegistrationParameters{Region: ProviderRegionBR, URL: "http://example.com"},
+			statusCode:   http.StatusOK,
+			responseBody: `"provider"`,
+			wantErr:      true,
+			wantRiotErr:  false,
+		},
+		{
+			name:           "success",
+			params:         ProviderRegistrationParameters{Region: ProviderRegionBR, URL: "http://example.com"},
+			statusCode:     http.StatusOK,
+			responseBody:   `1234`,
+			expectedResult: 1234,
+			wantErr:        false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDoer := mock.NewDefaultDoer(tt.statusCode, tt.responseBody, tt.httpErr)
+			rc := newTestRegionClient(mockDoer)
+			resp, err := rc.RegisterProvider(context.Background(), tt.params)
+
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				if tt.wantRiotErr {
+					var rErr *internal.RiotError
+					assert.ErrorAs(t, err, &rErr)
+					assert.Equal(t, tt.statusCode, rErr.StatusCode)
+				}
+				return
+			}
+
+			require.Nil(t, err)
+			assert.Equal(t, http.MethodPost, mockDoer.CapturedReq.Method)
+			assert.Equal(t, "/lol/tournament/v5/providers", mockDoer.CapturedReq.URL.Path)
+
+			body, err := io.ReadAll(mockDoer.CapturedReq.Body)
+			require.Nil(t, err)
+			assert.JSONEq(t, `{"region":"BR","url":"http://example.com"}`, string(body))
+
+			assert.Equal(t, tt.expectedResult, resp)
+		})
+	}
+}
+
+func TestRegisterTournament(t *testing.T) {
+	tests := []struct {
+		name           string
+		statusCode     int
+		httpErr        error
+		params         TournamentRegistrationParameters
+		responseBody   string
+		expectedResult int
+		wantErr        bool
+		wantRiotErr    bool
+	}{
+		{
+			name:         "riot error",
+			params:       TournamentRegistrationParameters{ProviderID: 1234, Name: "cup"},
+			statusCode:   http.StatusForbidden,
+			responseBody: `{"status":{"status_code":403}}`,
+			wantErr:      true,
+			wantRiotErr:  true,
+		},
+		{
+			name:           "success",
+			params:         TournamentRegistrationParameters{ProviderID: 1234, Name: "cup"},
+			statusCode:     http.StatusOK,
+			responseBody:   `5678`,
+			expectedResult: 5678,
+			wantErr:        false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDoer := mock.NewDefaultDoer(tt.statusCode, tt.responseBody, tt.httpErr)
+			rc := newTestRegionClient(mockDoer)
+			resp, err := rc.RegisterTournament(context.Background(), tt.params)
+
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				if tt.wantRiotErr {
+					var rErr *internal.RiotError
+					assert.ErrorAs(t, err, &rErr)
+					assert.Equal(t, tt.statusCode, rErr.StatusCode)
+				}
+				return
+			}
+
+			require.Nil(t, err)
+			assert.Equal(t, http.MethodPost, mockDoer.CapturedReq.Method)
+
+			body, err := io.ReadAll(mockDoer.CapturedReq.Body)
+			require.Nil(t, err)
+			assert.JSONEq(t, `{"providerId":1234,"name":"cup"}`, string(body))
+
+			assert.Equal(t, tt.expectedResult, resp)
+		})
+	}
+}
+
+func TestCreateCodes(t *testing.T) {
+	tests := []struct {
+		name           string
+		statusCode     int
+		httpErr        error
+		responseBody   string
+		expectedResult []string
+		wantErr        bool
+		wantRiotErr    bool
+	}{
+		{
+			name:         "riot error",
+			statusCode:   http.StatusBadRequest,
+			responseBody: `{"status":{"status_code":400}}`,
+			wantErr:      true,
+			wantRiotErr:  true,
+		},
+		{
+			name:         "invalid json",
+			statusCode:   http.StatusOK,
+			responseBody: `["BR1",`,
+			wantErr:      true,
+			wantRiotErr:  false,
+		},
+		{
+			name:           "success",
+			statusCode:     http.StatusOK,
+			responseBody:   `["BR0001-CODE-1","BR0001-CODE-2"]`,
+			expectedResult: []string{"BR0001-CODE-1", "BR0001-CODE-2"},
+			wantErr:        false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDoer := mock.NewDefaultDoer(tt.statusCode, tt.responseBody, tt.httpErr)
+			rc := newTestRegionClient(mockDoer)
+			resp, err := rc.CreateCodes(context.Background(), 5678, 2, CodeParameters{
+				AllowedParticipants: []string{"puuid-1"},
+				TeamSize:            5,
+				PickType:            PickTypeTournamentDraft,
+				MapType:             MapTypeSummonersRift,
+				SpectatorType:       SpectatorTypeAll,
+			})
+
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				if tt.wantRiotErr {
+					var rErr *internal.RiotError
+					assert.ErrorAs(t, err, &rErr)
+					assert.Equal(t, tt.statusCode, rErr.StatusCode)
+				}
+				return
+			}
+
+			require.Nil(t, err)
+			assert.Equal(t, http.MethodPost, mockDoer.CapturedReq.Method)
+			assert.Equal(t, "5678", mockDoer.CapturedReq.URL.Query().Get("tournamentId"))
+			assert.Equal(t, "2", mockDoer.CapturedReq.URL.Query().Get("count"))
+
+			body, err := io.ReadAll(mockDoer.CapturedReq.Body)
+			require.Nil(t, err)
+			assert.JSONEq(t, `{
+				"allowedParticipants":["puuid-1"],
+				"teamSize":5,
+				"pickType":"TOURNAMENT_DRAFT",
+				"mapType":"SUMMONERS_RIFT",
+				"spectatorType":"ALL",
+				"enoughPlayers":false
+			}`, string(body))
+
+			assert.Equal(t, tt.expectedResult, resp)
+		})
+	}
+}
+
+func TestGetCode(t *testing.T) {
+	tests := []struct {
+		name           string
+		statusCode     int
+		httpErr        error
+		responseBody   string
+		expectedResult Code
+		wantErr        bool
+		wantRiotErr    bool
+	}{
+		{
+			name:         "riot error",
+			statusCode:   http.StatusNotFound,
+			responseBody: `{"status":{"status_code":404}}`,
+			wantErr:      true,
+			wantRiotErr:  true,
+		},
+		{
+			name:       "success",
+			statusCode: http.StatusOK,
+			responseBody: `{
+				"code":"BR0001-CODE-1",
+				"spectators":"ALL",
+				"lobbyName":"lobby",
+				"metaData":"{\"round\":1}",
+				"password":"secret",
+				"teamSize":5,
+				"providerId":1234,
+				"pickType":"TOURNAMENT_DRAFT",
+				"tournamentId":5678,
+				"id":1,
+				"region":"BR",
+				"map":"SUMMONERS_RIFT",
+				"participants":["puuid-1"]
+			}`,
+			expectedResult: Code{
+				Code:         "BR0001-CODE-1",
+				Spectators:   SpectatorTypeAll,
+				LobbyName:    "lobby",
+				MetaData:     `{"round":1}`,
+				Password:     "secret",
+				TeamSize:     5,
+				ProviderID:   1234,
+				PickType:     PickTypeTournamentDraft,
+				TournamentID: 5678,
+				ID:           1,
+				Region:       "BR",
+				Map:          MapTypeSummonersRift,
+				Participants: []string{"puuid-1"},
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDoer := mock.NewDefaultDoer(tt.statusCode, tt.responseBody, tt.httpErr)
+			rc := newTestRegionClient(mockDoer)
+			resp, err := rc.GetCode(context.Background(), "BR0001-CODE-1")
+
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				if tt.wantRiotErr {
+					var rErr *internal.RiotError
+					assert.ErrorAs(t, err, &rErr)
+					assert.Equal(t, tt.statusCode, rErr.StatusCode)
+				}
+				return
+			}
+
+			require.Nil(t, err)
+			assert.Equal(t, "/lol/tournament/v5/codes/BR0001-CODE-1", mockDoer.CapturedReq.URL.Path)
+			assert.Equal(t, tt.expectedResult, resp)
+		})
+	}
+}
+
+func TestUpdateCode(t *testing.T) {
+	tests := []struct {
+		name         string
+		statusCode   int
+		httpErr      error
+		responseBody string
+		wantErr      bool
+		wantRiotErr  bool
+	}{
+		{
+			name:         "riot error",
+			statusCode:   http.StatusBadRequest,
+			responseBody: `{"status":{"status_code":400}}`,
+			wantErr:      true,
+			wantRiotErr:  true,
+		},
+		{
+			name:       "success",
+			statusCode: http.StatusOK,
+			wantErr:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDoer := mock.NewDefaultDoer(tt.statusCode, tt.responseBody, tt.httpErr)
+			rc := newTestRegionClient(mockDoer)
+			err := rc.UpdateCode(context.Background(), "BR0001-CODE-1", CodeUpdateParameters{
+				PickType:      PickTypeBlindPick,
+				MapType:       MapTypeHowlingAbyss,
+				SpectatorType: SpectatorTypeNone,
+			})
+
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				if tt.wantRiotErr {
+					var rErr *internal.RiotError
+					assert.ErrorAs(t, err, &rErr)
+					assert.Equal(t, tt.statusCode, rErr.StatusCode)
+				}
+				return
+			}
+
+			require.Nil(t, err)
+			assert.Equal(t, http.MethodPut, mockDoer.CapturedReq.Method)
+
+			body, err := io.ReadAll(mockDoer.CapturedReq.Body)
+			require.Nil(t, err)
+			assert.JSONEq(t, `{"pickType":"BLIND_PICK","mapType":"HOWLING_ABYSS","spectatorType":"NONE"}`, string(body))
+		})
+	}
+}
+
+func TestGetGamesByCode(t *testing.T) {
+	tests := []struct {
+		name           string
+		statusCode     int
+		httpErr        error
+		responseBody   string
+		expectedResult []Game
+		wantErr        bool
+		wantRiotErr    bool
+	}{
+		{
+			name:         "riot error",
+			statusCode:   http.StatusNotFound,
+			responseBody: `{"status":{"status_code":404}}`,
+			wantErr:      true,
+			wantRiotErr:  true,
+		},
+		{
+			name:         "unmatched json",
+			statusCode:   http.StatusOK,
+			responseBody: `{"gameId":1}`,
+			wantErr:      true,
+			wantRiotErr:  false,
+		},
+		{
+			name:       "success",
+			statusCode: http.StatusOK,
+			responseBody: `[{
+				"winningTeam":[{"puuid":"puuid-1"}],
+				"losingTeam":[{"puuid":"puuid-2"}],
+				"shortCode":"BR0001-CODE-1",
+				"metaData":"",
+				"gameId":3000000000,
+				"gameName":"game",
+				"gameType":"CUSTOM_GAME",
+				"gameMap":11,
+				"gameMode":"CLASSIC",
+				"region":"BR1"
+			}]`,
+			expectedResult: []Game{
+				{
+					WinningTeam: []Player{{PUUID: "puuid-1"}},
+					LosingTeam:  []Player{{PUUID: "puuid-2"}},
+					ShortCode:   "BR0001-CODE-1",
+					GameID:      3000000000,
+					GameName:    "game",
+					GameType:    "CUSTOM_GAME",
+					GameMap:     11,
+					GameMode:    "CLASSIC",
+					Region:      "BR1",
+				},
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDoer := mock.NewDefaultDoer(tt.statusCode, tt.responseBody, tt.httpErr)
+			rc := newTestRegionClient(mockDoer)
+			resp, err := rc.GetGamesByCode(context.Background(), "BR0001-CODE-1")
+
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				if tt.wantRiotErr {
+					var rErr *internal.RiotError
+					assert.ErrorAs(t, err, &rErr)
+					assert.Equal(t, tt.statusCode, rErr.StatusCode)
+				}
+				return
+			}
+
+			require.Nil(t, err)
+			assert.Equal(t, tt.expectedResult, resp)
+		})
+	}
+}
+
+func TestGetLobbyEventsByCode(t *testing.T) {
+	tests := []struct {
+		name           string
+		statusCode     int
+		httpErr        error
+		responseBody   string
+		expectedResult LobbyEvents
+		wantErr        bool
+		wantRiotErr    bool
+	}{
+		{
+			name:         "riot error",
+			statusCode:   http.StatusNotFound,
+			responseBody: `{"status":{"status_code":404}}`,
+			wantErr:      true,
+			wantRiotErr:  true,
+		},
+		{
+			name:         "success",
+			statusCode:   http.StatusOK,
+			responseBody: `{"eventList":[{"timestamp":"1700000000000","eventType":"PlayerJoinedGameEvent","puuid":"puuid-1"}]}`,
+			expectedResult: LobbyEvents{
+				EventList: []LobbyEvent{{Timestamp: "1700000000000", EventType: "PlayerJoinedGameEvent", PUUID: "puuid-1"}},
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDoer := mock.NewDefaultDoer(tt.statusCode, tt.responseBody, tt.httpErr)
+			rc := newTestRegionClient(mockDoer)
+			resp, err := rc.GetLobbyEventsByCode(context.Background(), "BR0001-CODE-1")
+
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				if tt.wantRiotErr {
+					var rErr *internal.RiotError
+					assert.ErrorAs(t, err, &rErr)
+					assert.Equal(t, tt.statusCode, rErr.StatusCode)
+				}
+				return
+			}
+
+			require.Nil(t, err)
+			assert.Equal(t, "/lol/tournament/v5/lobby-events/by-code/BR0001-CODE-1", mockDoer.CapturedReq.URL.Path)
+			assert.Equal(t, tt.expectedResult, resp)
+		})
+	}
+}
+
+func TestRegisterProviderServerErrorNotRetried(t *testing.T) {
+	// The provider may have been registered before the error, retrying could register it twice.
+	doer := &mock.SequenceDoer{
+		Responses: []*http.Response{
+			mock.NewResponse(http.StatusBadGateway, ""),
+			mock.NewResponse(http.StatusOK, "1234"),
+		},
+	}
+	rc := NewRegionClient(internal.NewHttpClient(doer, slog.Default(), string(regions.RegionAmericas), "apiKey"))
+
+	_, err := rc.RegisterProvider(context.Background(), ProviderRegistrationParameters{Region: ProviderRegionBR, URL: "http://example.com"})
+
+	var rErr *internal.RiotError
+	require.ErrorAs(t, err, &rErr)
+	assert.Equal(t, http.StatusBadGateway, rErr.StatusCode)
+	assert.Len(t, doer.CapturedReqs, 1)
+}
@@ -0,0 +1,144 @@
+package tournamentstub
+
+import (
+	"context"
+	"fmt"
+	"leago/api/lol/tournament"
+	"leago/internal"
+	"leago/options"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+const (
+	MethodRegisterProvider     = "TournamentStub.RegisterProvider"
+	MethodRegisterTournament   = "TournamentStub.RegisterTournament"
+	MethodCreateCodes          = "TournamentStub.CreateCodes"
+	MethodGetCode              = "TournamentStub.GetCode"
+	MethodGetLobbyEventsByCode = "TournamentStub.GetLobbyEventsByCode"
+)
+
+// Ensures the stub is a drop-in for the tournament client.
+var _ tournament.API = (*RegionClient)(nil)
+
+// RegisterProvider registers the provider callback URL and returns the provider ID.
+func (rc *RegionClient) RegisterProvider(
+	ctx context.Context,
+	params tournament.ProviderRegistrationParameters,
+	opts ...options.PublicOption,
+) (int, error) {
+	endpoint := "/lol/tournament-stub/v5/providers"
+
+	defaultOpts := []internal.RequestOption{
+		internal.WithApiMethod(MethodRegisterProvider),
+		internal.WithHttpMethod(http.MethodPost),
+		internal.WithBody(params),
+	}
+
+	uri := rc.client.GetURL(endpoint)
+	return internal.AuthRequest[int](
+		ctx,
+		rc.client,
+		uri,
+		options.MergeOptions(defaultOpts, opts)...,
+	)
+}
+
+// RegisterTournament creates a tournament for the provider and returns the tournament ID.
+func (rc *RegionClient) RegisterTournament(
+	ctx context.Context,
+	params tournament.TournamentRegistrationParameters,
+	opts ...options.PublicOption,
+) (int, error) {
+	endpoint := "/lol/tournament-stub/v5/tournaments"
+
+	defaultOpts := []internal.RequestOption{
+		internal.WithApiMethod(MethodRegisterTournament),
+		internal.WithHttpMethod(http.MethodPost),
+		internal.WithBody(params),
+	}
+
+	uri := rc.client.GetURL(endpoint)
+	return internal.AuthRequest[int](
+		ctx,
+		rc.client,
+		uri,
+		options.MergeOptions(defaultOpts, opts)...,
+	)
+}
+
+// CreateCodes generates count tournament codes, between 1 and 1000, for the tournament.
+func (rc *RegionClient) CreateCodes(
+	ctx context.Context,
+	tournamentID int,
+	count int,
+	params tournament.CodeParameters,
+	opts ...options.PublicOption,
+) ([]string, error) {
+	endpoint := "/lol/tournament-stub/v5/codes"
+
+	defaultOpts := []internal.RequestOption{
+		internal.WithApiMethod(MethodCreateCodes),
+		internal.WithHttpMethod(http.MethodPost),
+		internal.WithBody(params),
+		internal.WithParam("tournamentId", strconv.Itoa(tournamentID)),
+		internal.WithParam("count", strconv.Itoa(count)),
+	}
+
+	uri := rc.client.GetURL(endpoint)
+	return internal.AuthRequest[[]string](
+		ctx,
+		rc.client,
+		uri,
+		options.MergeOptions(defaultOpts, opts)...,
+	)
+}
+
+// GetCode returns the tournament code details.
+func (rc *RegionClient) GetCode(
+	ctx context.Context,
+	tournamentCode string,
+	opts ...options.PublicOption,
+) (tournament.Code, error) {
+	endpoint := fmt.Sprintf(
+		"/lol/tournament-stub/v5/codes/%s",
+		url.PathEscape(tournamentCode),
+	)
+
+	defaultOpts := []internal.RequestOption{
+		internal.WithApiMethod(MethodGetCode),
+	}
+
+	uri := rc.client.GetURL(endpoint)
+	return internal.AuthRequest[tournament.Code](
+		ctx,
+		rc.client,
+		uri,
+		options.MergeOptions(defaultOpts, opts)...,
+	)
+}
+
+// GetLobbyEventsByCode returns the lobby events, like players joining and champion select starting, of the tournament code.
+func (rc *RegionClient) GetLobbyEventsByCode(
+	ctx context.Context,
+	tournamentCode string,
+	opts ...options.PublicOption,
+) (tournament.LobbyEvents, error) {
+	endpoint := fmt.Sprintf(
+		"/lol/tournament-stub/v5/lobby-events/by-code/%s",
+		url.PathEscape(tournamentCode),
+	)
+
+	defaultOpts := []internal.RequestOption{
+		internal.WithApiMethod(MethodGetLobbyEventsByCode),
+	}
+
+	uri := rc.client.GetURL(endpoint)
+	return internal.AuthRequest[tournament.LobbyEvents](
+		ctx,
+		rc.client,
+		uri,
+		options.MergeOptions(defaultOpts, opts)...,
+	)
+}
@@ -0,0 +1,106 @@
+package tournamentstub
+
+import (
+	"context"
+	"leago/api/lol/tournament"
+	"leago/internal"
+	"leago/internal/mock"
+	"leago/regions"
+	"log/slog"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStubEndpoints(t *testing.T) {
+	tests := []struct {
+		name         string
+		responseBody string
+		call         func(api tournament.API) (any, error)
+		wantMethod   string
+		wantPath     string
+		expected     any
+	}{
+		{
+			name:         "register provider",
+			responseBody: `1`,
+			call: func(api tournament.API) (any, error) {
+				return api.RegisterProvider(context.Background(), tournament.ProviderRegistrationParameters{
+					Region: tournament.ProviderRegionNA,
+					URL:    "http://example.com",
+				})
+			},
+			wantMethod: http.MethodPost,
+			wantPath:   "/lol/tournament-stub/v5/providers",
+			expected:   1,
+		},
+		{
+			name:         "register tournament",
+			responseBody: `2`,
+			call: func(api tournament.API) (any, error) {
+				return api.RegisterTournament(context.Background(), tournament.TournamentRegistrationParameters{ProviderID: 1})
+			},
+			wantMethod: http.MethodPost,
+			wantPath:   "/lol/tournament-stub/v5/tournaments",
+			expected:   2,
+		},
+		{
+			name:         "create codes",
+			responseBody: `["NA0001-STUB"]`,
+			call: func(api tournament.API) (any, error) {
+				return api.CreateCodes(context.Background(), 2, 1, tournament.CodeParameters{TeamSize: 5})
+			},
+			wantMethod: http.MethodPost,
+			wantPath:   "/lol/tournament-stub/v5/codes",
+			expected:   []string{"NA0001-STUB"},
+		},
+		{
+			name:         "get code",
+			responseBody: `{"code":"NA0001-STUB","tournamentId":2}`,
+			call: func(api tournament.API) (any, error) {
+				return api.GetCode(context.Background(), "NA0001-STUB")
+			},
+			wantMethod: http.MethodGet,
+			wantPath:   "/lol/tournament-stub/v5/codes/NA0001-STUB",
+			expected:   tournament.Code{Code: "NA0001-STUB", TournamentID: 2},
+		},
+		{
+			name:         "get lobby events",
+			responseBody: `{"eventList":[]}`,
+			call: func(api tournament.API) (any, error) {
+				return api.GetLobbyEventsByCode(context.Background(), "NA0001-STUB")
+			},
+			wantMethod: http.MethodGet,
+			wantPath:   "/lol/tournament-stub/v5/lobby-events/by-code/NA0001-STUB",
+			expected:   tournament.LobbyEvents{EventList: []tournament.LobbyEvent{}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDoer := mock.NewDefaultDoer(http.StatusOK, tt.responseBody, nil)
+			baseClient := internal.NewHttpClient(mockDoer, slog.Default(), string(regions.RegionAmericas), "apiKey")
+
+			resp, err := tt.call(NewRegionClient(baseClient))
+			require.Nil(t, err)
+
+			assert.Equal(t, tt.wantMethod, mockDoer.CapturedReq.Method)
+			assert.Equal(t, tt.wantPath, mockDoer.CapturedReq.URL.Path)
+			assert.Equal(t, tt.expected, resp)
+		})
+	}
+}
+
+func TestStubRiotError(t *testing.T) {
+	mockDoer := mock.NewDefaultDoer(http.StatusBadRequest, `{"status":{"status_code":400}}`, nil)
+	baseClient := internal.NewHttpClient(mockDoer, slog.Default(), string(regions.RegionAmericas), "apiKey")
+	rc := NewRegionClient(baseClient)
+
+	_, err := rc.CreateCodes(context.Background(), 2, 1, tournament.CodeParameters{})
+
+	var rErr *internal.RiotError
+	require.ErrorAs(t, err, &rErr)
+	assert.Equal(t, http.StatusBadRequest, rErr.StatusCode)
+}
@@ -0,0 +1,21 @@
+package deck
+
+import "leago/api/lor/deckcode"
+
+type (
+	Deck struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+		Code string `json:"code"`
+	}
+
+	NewDeck struct {
+		Name string `json:"name"`
+		Code string `json:"code"`
+	}
+)
+
+// Cards decodes the deck code of the deck.
+func (d Deck) Cards() (deckcode.Deck, error) {
+	return deckcode.Decode(d.Code)
+}
@@ -0,0 +1,61 @@
+package deck
+
+import (
+	"context"
+	"leago/internal"
+	"leago/options"
+	"net/http"
+)
+
+const (
+	MethodGetDecks   = "LorDeck.GetDecks"
+	MethodCreateDeck = "LorDeck.CreateDeck"
+)
+
+// GetDecks returns the decks of the player that authorized the RSO access token.
+func (rc *RegionClient) GetDecks(
+	ctx context.Context,
+	accessToken string,
+	opts ...options.PublicOption,
+) ([]Deck, error) {
+	endpoint := "/lor/deck/v1/decks/me"
+
+	defaultOpts := []internal.RequestOption{
+		internal.WithApiMethod(MethodGetDecks),
+		internal.WithBearerToken(accessToken),
+	}
+
+	uri := rc.client.GetURL(endpoint)
+	return internal.AuthRequest[[]Deck](
+		ctx,
+		rc.client,
+		uri,
+		options.MergeOptions(defaultOpts, opts)...,
+	)
+}
+
+// CreateDeck creates a deck for the player that authorized the RSO access token and returns its ID.
+// Server errors are not retried, since the deck may have been created before the error.
+func (rc *RegionClient) CreateDeck(
+	ctx context.Context,
+	accessToken string,
+	deck NewDeck,
+	opts ...options.PublicOption,
+) (string, error) {
+	endpoint := "/lor/deck/v1/decks/me"
+
+	defaultOpts := []internal.RequestOption{
+		internal.WithApiMethod(MethodCreateDeck),
+		internal.WithBearerToken(accessToken),
+		internal.WithHttpMethod(http.MethodPost),
+		internal.WithBody(deck),
+	}
+
+	uri := rc.client.GetURL(endpoint)
+	return internal.AuthRequest[string](
+		ctx,
+		rc.client,
+		uri,
+		options.MergeOptions(defaultOpts, opts)...,
+	)
+}
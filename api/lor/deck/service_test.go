@@ -0,0 +1,158 @@
+package deck
+
+import (
+	"context"
+	"io"
+	"leago/internal"
+	"leago/internal/mock"
+	"leago/regions"
+	"log/slog"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetDecks(t *testing.T) {
+	tests := []struct {
+		name           string
+		statusCode     int
+		httpErr        error
+		responseBody   string
+		expectedResult []Deck
+		wantErr        bool
+		wantRiotErr    bool
+	}{
+		{
+			name:         "riot error",
+			statusCode:   http.StatusUnauthorized,
+			responseBody: `{"status":{"status_code":401}}`,
+			wantErr:      true,
+			wantRiotErr:  true,
+		},
+		{
+			name:         "unmatched json",
+			statusCode:   http.StatusOK,
+			responseBody: `{"id":"deck"}`,
+			wantErr:      true,
+			wantRiotErr:  false,
+		},
+		{
+			name:           "success",
+			statusCode:     http.StatusOK,
+			responseBody:   `[{"id":"deck","name":"Deck","code":"CEBAIAIFB4WDANQIAEAQGDAUDAQSIJZUAIAQCBIFAEAQCBAA"}]`,
+			expectedResult: []Deck{{ID: "deck", Name: "Deck", Code: "CEBAIAIFB4WDANQIAEAQGDAUDAQSIJZUAIAQCBIFAEAQCBAA"}},
+			wantErr:        false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDoer := mock.NewDefaultDoer(tt.statusCode, tt.responseBody, tt.httpErr)
+			baseClient := internal.NewHttpClient(mockDoer, slog.Default(), string(regions.RegionAmericas), "apiKey")
+			rc := NewRegionClient(baseClient)
+			resp, err := rc.GetDecks(context.Background(), "accessToken")
+
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				if tt.wantRiotErr {
+					var rErr *internal.RiotError
+					assert.ErrorAs(t, err, &rErr)
+					assert.Equal(t, tt.statusCode, rErr.StatusCode)
+				}
+				return
+			}
+
+			require.Nil(t, err)
+			assert.Equal(t, "/lor/deck/v1/decks/me", mockDoer.CapturedReq.URL.Path)
+			assert.Equal(t, "Bearer accessToken", mockDoer.CapturedReq.Header.Get("Authorization"))
+			assert.Equal(t, tt.expectedResult, resp)
+
+			cards, err := resp[0].Cards()
+			require.Nil(t, err)
+			assert.Equal(t, 40, cards.Size())
+		})
+	}
+}
+
+func TestCreateDeck(t *testing.T) {
+	tests := []struct {
+		name           string
+		statusCode     int
+		httpErr        error
+		responseBody   string
+		expectedResult string
+		wantErr        bool
+		wantRiotErr    bool
+	}{
+		{
+			name:         "riot error",
+			statusCode:   http.StatusBadRequest,
+			responseBody: `{"status":{"status_code":400}}`,
+			wantErr:      true,
+			wantRiotErr:  true,
+		},
+		{
+			name:         "unmatched json",
+			statusCode:   http.StatusOK,
+			responseBody: `{"id":"deck"}`,
+			wantErr:      true,
+			wantRiotErr:  false,
+		},
+		{
+			name:           "success",
+			statusCode:     http.StatusOK,
+			responseBody:   `"deck"`,
+			expectedResult: "deck",
+			wantErr:        false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDoer := mock.NewDefaultDoer(tt.statusCode, tt.responseBody, tt.httpErr)
+			baseClient := internal.NewHttpClient(mockDoer, slog.Default(), string(regions.RegionAmericas), "apiKey")
+			rc := NewRegionClient(baseClient)
+			resp, err := rc.CreateDeck(context.Background(), "accessToken", NewDeck{Name: "Deck", Code: "code"})
+
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				if tt.wantRiotErr {
+					var rErr *internal.RiotError
+					assert.ErrorAs(t, err, &rErr)
+					assert.Equal(t, tt.statusCode, rErr.StatusCode)
+				}
+				return
+			}
+
+			require.Nil(t, err)
+			assert.Equal(t, http.MethodPost, mockDoer.CapturedReq.Method)
+			assert.Equal(t, "/lor/deck/v1/decks/me", mockDoer.CapturedReq.URL.Path)
+			assert.Equal(t, "Bearer accessToken", mockDoer.CapturedReq.Header.Get("Authorization"))
+
+			body, err := io.ReadAll(mockDoer.CapturedReq.Body)
+			require.Nil(t, err)
+			assert.JSONEq(t, `{"name":"Deck","code":"code"}`, string(body))
+
+			assert.Equal(t, tt.expectedResult, resp)
+		})
+	}
+}
+
+func TestCreateDeckServerErrorNotRetried(t *testing.T) {
+	doer := &mock.SequenceDoer{
+		Responses: []*http.Response{
+			mock.NewResponse(http.StatusGatewayTimeout, ""),
+			mock.NewResponse(http.StatusOK, `"deck"`),
+		},
+	}
+	rc := NewRegionClient(internal.NewHttpClient(doer, slog.Default(), string(regions.RegionAmericas), "apiKey"))
+
+	_, err := rc.CreateDeck(context.Background(), "accessToken", NewDeck{Name: "Deck", Code: "code"})
+
+	var rErr *internal.RiotError
+	require.ErrorAs(t, err, &rErr)
+	assert.Equal(t, http.StatusGatewayTimeout, rErr.StatusCode)
+	assert.Len(t, doer.CapturedReqs, 1)
+}
@@ -0,0 +1,258 @@
+package deckcode
+
+import (
+	"cmp"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"slices"
+)
+
+type (
+	// Deck is the list of cards of a deck code.
+	Deck []Card
+
+	// group is a set of cards with the same count, set and faction, encoded together.
+	group struct {
+		set     int
+		faction Faction
+		numbers []int
+	}
+
+	reader struct {
+		data []byte
+	}
+)
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// Decode returns the cards of the deck code.
+func Decode(code string) (Deck, error) {
+	data, err := encoding.DecodeString(code)
+	if err != nil || len(data) == 0 {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidCode, code)
+	}
+
+	format, version := int(data[0]>>4), int(data[0]&0xF)
+	if format != Format || version > MaxVersion {
+		return nil, fmt.Errorf("%w: format %d version %d", ErrUnsupportedVersion, format, version)
+	}
+
+	r := &reader{data: data[1:]}
+
+	var deck Deck
+	// Cards with 3, 2 and 1 copies are grouped by set and faction.
+	for count := 3; count > 0; count-- {
+		if deck, err = r.groups(deck, count); err != nil {
+			return nil, err
+		}
+	}
+
+	// Cards with more than 3 copies are written one by one with their count.
+	for len(r.data) > 0 {
+		if deck, err = r.card(deck); err != nil {
+			return nil, err
+		}
+	}
+
+	return deck, nil
+}
+
+// Encode returns the deck code of the cards, using the lowest version able to read all of its factions.
+// The cards are grouped the same way as the game client, so decoded decks encode back to the same code.
+func Encode(deck Deck) (string, error) {
+	byCount := map[int][]CardCode{}
+	var extra []Card
+	for _, card := range deck {
+		cc, err := ParseCardCode(card.Code)
+		if err != nil {
+			return "", err
+		}
+
+		if card.Count < 1 {
+			return "", fmt.Errorf("%w: %q has count %d", ErrInvalidCard, card.Code, card.Count)
+		}
+
+		if card.Count > 3 {
+			extra = append(extra, card)
+			continue
+		}
+		byCount[card.Count] = append(byCount[card.Count], cc)
+	}
+
+	version, err := deck.Version()
+	if err != nil {
+		return "", err
+	}
+
+	data := []byte{byte(Format<<4 | version)}
+	for count := 3; count > 0; count-- {
+		groups := groupCards(byCount[count])
+
+		data = binary.AppendUvarint(data, uint64(len(groups)))
+		for _, g := range groups {
+			data = binary.AppendUvarint(data, uint64(len(g.numbers)))
+			data = binary.AppendUvarint(data, uint64(g.set))
+			data = binary.AppendUvarint(data, factionIDs[g.faction])
+			for _, number := range g.numbers {
+				data = binary.AppendUvarint(data, uint64(number))
+			}
+		}
+	}
+
+	slices.SortFunc(extra, func(a, b Card) int {
+		return cmp.Compare(a.Code, b.Code)
+	})
+	for _, card := range extra {
+		// The code was already validated while splitting the cards.
+		cc, _ := ParseCardCode(card.Code)
+		data = binary.AppendUvarint(data, uint64(card.Count))
+		data = binary.AppendUvarint(data, uint64(cc.Set))
+		data = binary.AppendUvarint(data, factionIDs[cc.Faction])
+		data = binary.AppendUvarint(data, uint64(cc.Number))
+	}
+
+	return encoding.EncodeToString(data), nil
+}
+
+// Version returns the lowest deck code version able to read all the factions of the deck.
+func (d Deck) Version() (int, error) {
+	version := 1
+	for _, card := range d {
+		cc, err := ParseCardCode(card.Code)
+		if err != nil {
+			return 0, err
+		}
+		version = max(version, cc.Faction.Version())
+	}
+	return version, nil
+}
+
+// Factions returns the factions of the deck in order of appearance, ignoring invalid card codes.
+func (d Deck) Factions() []Faction {
+	var out []Faction
+	for _, card := range d {
+		cc, err := ParseCardCode(card.Code)
+		if err != nil {
+			continue
+		}
+		if !slices.Contains(out, cc.Faction) {
+			out = append(out, cc.Faction)
+		}
+	}
+	return out
+}
+
+// Size returns the total number of cards of the deck.
+func (d Deck) Size() int {
+	var size int
+	for _, card := range d {
+		size += card.Count
+	}
+	return size
+}
+
+// groupCards groups the cards by set and faction in order of appearance, then sorts the groups by size.
+// Keeping the order of appearance means decoding and encoding a deck code returns the same code.
+func groupCards(cards []CardCode) []group {
+	var groups []group
+	for _, cc := range cards {
+		i := slices.IndexFunc(groups, func(g group) bool {
+			return g.set == cc.Set && g.faction == cc.Faction
+		})
+		if i < 0 {
+			groups = append(groups, group{set: cc.Set, faction: cc.Faction})
+			i = len(groups) - 1
+		}
+		groups[i].numbers = append(groups[i].numbers, cc.Number)
+	}
+
+	for _, g := range groups {
+		slices.Sort(g.numbers)
+	}
+	slices.SortStableFunc(groups, func(a, b group) int {
+		return cmp.Compare(len(a.numbers), len(b.numbers))
+	})
+	return groups
+}
+
+func cardCode(set uint64, faction Faction, number uint64) string {
+	return CardCode{Set: int(set), Faction: faction, Number: int(number)}.String()
+}
+
+// uvarint reads the next varint of the code.
+func (r *reader) uvarint() (uint64, error) {
+	v, n := binary.Uvarint(r.data)
+	if n <= 0 {
+		return 0, fmt.Errorf("%w: truncated data", ErrInvalidCode)
+	}
+	r.data = r.data[n:]
+	return v, nil
+}
+
+// groups reads the groups of cards with count copies, appending them to the deck.
+func (r *reader) groups(deck Deck, count int) (Deck, error) {
+	groups, err := r.uvarint()
+	if err != nil {
+		return nil, err
+	}
+
+	for range groups {
+		cards, err := r.uvarint()
+		if err != nil {
+			return nil, err
+		}
+
+		set, faction, err := r.setAndFaction()
+		if err != nil {
+			return nil, err
+		}
+
+		for range cards {
+			number, err := r.uvarint()
+			if err != nil {
+				return nil, err
+			}
+			deck = append(deck, Card{Code: cardCode(set, faction, number), Count: count})
+		}
+	}
+	return deck, nil
+}
+
+// card reads a single card with its count, appending it to the deck.
+func (r *reader) card(deck Deck) (Deck, error) {
+	count, err := r.uvarint()
+	if err != nil {
+		return nil, err
+	}
+
+	set, faction, err := r.setAndFaction()
+	if err != nil {
+		return nil, err
+	}
+
+	number, err := r.uvarint()
+	if err != nil {
+		return nil, err
+	}
+	return append(deck, Card{Code: cardCode(set, faction, number), Count: int(count)}), nil
+}
+
+// setAndFaction reads the set and the faction identifier of the next cards.
+func (r *reader) setAndFaction() (uint64, Faction, error) {
+	set, err := r.uvarint()
+	if err != nil {
+		return 0, "", err
+	}
+
+	id, err := r.uvarint()
+	if err != nil {
+		return 0, "", err
+	}
+
+	faction, ok := factionsByID[id]
+	if !ok {
+		return 0, "", fmt.Errorf("%w: unknown faction %d", ErrInvalidCode, id)
+	}
+	return set, faction, nil
+}
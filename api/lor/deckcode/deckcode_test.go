@@ -0,0 +1,174 @@
+package deckcode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const readmeDeckCode = "CEBAIAIFB4WDANQIAEAQGDAUDAQSIJZUAIAQCBIFAEAQCBAA"
+
+var readmeDeck = Deck{
+	{Code: "01SI015", Count: 3},
+	{Code: "01SI044", Count: 3},
+	{Code: "01SI048", Count: 3},
+	{Code: "01SI054", Count: 3},
+	{Code: "01FR003", Count: 3},
+	{Code: "01FR012", Count: 3},
+	{Code: "01FR020", Count: 3},
+	{Code: "01FR024", Count: 3},
+	{Code: "01FR033", Count: 3},
+	{Code: "01FR036", Count: 3},
+	{Code: "01FR039", Count: 3},
+	{Code: "01FR052", Count: 3},
+	{Code: "01SI005", Count: 2},
+	{Code: "01FR004", Count: 2},
+}
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		name     string
+		code     string
+		expected Deck
+		wantErr  error
+	}{
+		{
+			name:     "success",
+			code:     readmeDeckCode,
+			expected: readmeDeck,
+		},
+		{
+			name:    "invalid base32",
+			code:    "not a deck code",
+			wantErr: ErrInvalidCode,
+		},
+		{
+			name:    "empty",
+			code:    "",
+			wantErr: ErrInvalidCode,
+		},
+		{
+			name:    "truncated",
+			code:    readmeDeckCode[:20],
+			wantErr: ErrInvalidCode,
+		},
+		{
+			name:    "unsupported version",
+			code:    encoding.EncodeToString([]byte{Format<<4 | (MaxVersion + 1), 0, 0, 0}),
+			wantErr: ErrUnsupportedVersion,
+		},
+		{
+			name:    "unsupported format",
+			code:    encoding.EncodeToString([]byte{2<<4 | 1, 0, 0, 0}),
+			wantErr: ErrUnsupportedVersion,
+		},
+		{
+			name:    "unknown faction",
+			code:    encoding.EncodeToString([]byte{Format<<4 | 1, 0, 0, 1, 1, 1, 8, 1}),
+			wantErr: ErrInvalidCode,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deck, err := Decode(tt.code)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, deck)
+		})
+	}
+}
+
+func TestEncode(t *testing.T) {
+	tests := []struct {
+		name            string
+		deck            Deck
+		expectedVersion byte
+		wantErr         error
+	}{
+		{
+			name:            "original factions",
+			deck:            readmeDeck,
+			expectedVersion: 1,
+		},
+		{
+			name:            "bilgewater",
+			deck:            Deck{{Code: "02BW001", Count: 3}, {Code: "01DE001", Count: 1}},
+			expectedVersion: 2,
+		},
+		{
+			name:            "runeterra",
+			deck:            Deck{{Code: "06RU001", Count: 1}, {Code: "04SH001", Count: 2}},
+			expectedVersion: 5,
+		},
+		{
+			name:            "more than 3 copies",
+			deck:            Deck{{Code: "01DE002", Count: 4}, {Code: "01DE001", Count: 6}, {Code: "02IO010", Count: 1}},
+			expectedVersion: 1,
+		},
+		{
+			name:    "invalid card code",
+			deck:    Deck{{Code: "01XX001", Count: 1}},
+			wantErr: ErrInvalidCard,
+		},
+		{
+			name:    "invalid count",
+			deck:    Deck{{Code: "01DE001", Count: 0}},
+			wantErr: ErrInvalidCard,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, err := Encode(tt.deck)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			data, err := encoding.DecodeString(code)
+			require.NoError(t, err)
+			assert.Equal(t, byte(Format<<4)|tt.expectedVersion, data[0])
+
+			deck, err := Decode(code)
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tt.deck, deck)
+		})
+	}
+}
+
+func TestEncodeRoundTrip(t *testing.T) {
+	deck, err := Decode(readmeDeckCode)
+	require.NoError(t, err)
+
+	code, err := Encode(deck)
+	require.NoError(t, err)
+	assert.Equal(t, readmeDeckCode, code)
+}
+
+func TestParseCardCode(t *testing.T) {
+	cc, err := ParseCardCode("01DE012")
+	require.NoError(t, err)
+	assert.Equal(t, CardCode{Set: 1, Faction: FactionDemacia, Number: 12}, cc)
+	assert.Equal(t, "01DE012", cc.String())
+
+	for _, code := range []string{"", "01DE01", "AADE001", "01XX001", "01DEAAA", "-1DE001"} {
+		_, err := ParseCardCode(code)
+		assert.ErrorIs(t, err, ErrInvalidCard, code)
+	}
+}
+
+func TestDeck(t *testing.T) {
+	assert.Equal(t, []Faction{FactionShadowIsles, FactionFreljord}, readmeDeck.Factions())
+	assert.Equal(t, 40, readmeDeck.Size())
+
+	version, err := readmeDeck.Version()
+	require.NoError(t, err)
+	assert.Equal(t, 1, version)
+}
@@ -0,0 +1,133 @@
+package deckcode
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+type (
+	// Card is a card of the deck with the number of copies.
+	Card struct {
+		Code  string
+		Count int
+	}
+
+	// CardCode is a parsed card code, like 01DE001 being the card 1 of Demacia on set 1.
+	CardCode struct {
+		Set     int
+		Faction Faction
+		Number  int
+	}
+
+	// Faction is the two letters identifier of the region of a card.
+	Faction string
+)
+
+const (
+	FactionDemacia      Faction = "DE"
+	FactionFreljord     Faction = "FR"
+	FactionIonia        Faction = "IO"
+	FactionNoxus        Faction = "NX"
+	FactionPiltoverZaun Faction = "PZ"
+	FactionShadowIsles  Faction = "SI"
+	FactionBilgewater   Faction = "BW"
+	FactionShurima      Faction = "SH"
+	FactionTargon       Faction = "MT"
+	FactionBandleCity   Faction = "BC"
+	FactionRuneterra    Faction = "RU"
+
+	// Format is the deck code format written by Encode and the only one read by Decode.
+	Format = 1
+
+	// MaxVersion is the highest library version known, codes of newer versions can't be decoded.
+	MaxVersion = 5
+
+	cardCodeLength = 7
+)
+
+var (
+	ErrInvalidCode        = errors.New("invalid deck code")
+	ErrUnsupportedVersion = errors.New("unsupported deck code version")
+	ErrInvalidCard        = errors.New("invalid card")
+
+	// factionIDs are the faction identifiers written on the deck code.
+	factionIDs = map[Faction]uint64{
+		FactionDemacia:      0,
+		FactionFreljord:     1,
+		FactionIonia:        2,
+		FactionNoxus:        3,
+		FactionPiltoverZaun: 4,
+		FactionShadowIsles:  5,
+		FactionBilgewater:   6,
+		FactionShurima:      7,
+		FactionTargon:       9,
+		FactionBandleCity:   10,
+		FactionRuneterra:    12,
+	}
+
+	// factionVersions are the minimum library version able to read each faction.
+	factionVersions = map[Faction]int{
+		FactionDemacia:      1,
+		FactionFreljord:     1,
+		FactionIonia:        1,
+		FactionNoxus:        1,
+		FactionPiltoverZaun: 1,
+		FactionShadowIsles:  1,
+		FactionBilgewater:   2,
+		FactionTargon:       2,
+		FactionShurima:      3,
+		FactionBandleCity:   4,
+		FactionRuneterra:    5,
+	}
+
+	factionsByID = buildFactionsByID()
+)
+
+// ParseCardCode parses a card code, like 01DE001.
+func ParseCardCode(code string) (CardCode, error) {
+	if len(code) != cardCodeLength {
+		return CardCode{}, fmt.Errorf("%w: %q", ErrInvalidCard, code)
+	}
+
+	set, err := strconv.Atoi(code[:2])
+	if err != nil || set < 0 {
+		return CardCode{}, fmt.Errorf("%w: %q", ErrInvalidCard, code)
+	}
+
+	faction := Faction(code[2:4])
+	if !faction.Valid() {
+		return CardCode{}, fmt.Errorf("%w: unknown faction on %q", ErrInvalidCard, code)
+	}
+
+	number, err := strconv.Atoi(code[4:])
+	if err != nil || number < 0 {
+		return CardCode{}, fmt.Errorf("%w: %q", ErrInvalidCard, code)
+	}
+
+	return CardCode{Set: set, Faction: faction, Number: number}, nil
+}
+
+// String returns the card code, like 01DE001.
+func (c CardCode) String() string {
+	return fmt.Sprintf("%02d%s%03d", c.Set, c.Faction, c.Number)
+}
+
+// Valid returns if the faction is a known one.
+func (f Faction) Valid() bool {
+	_, ok := factionIDs[f]
+	return ok
+}
+
+// Version returns the minimum library version able to read the faction, zero if unknown.
+func (f Faction) Version() int {
+	return factionVersions[f]
+}
+
+func buildFactionsByID() map[uint64]Faction {
+	out := make(map[uint64]Faction, len(factionIDs))
+	for faction, id := range factionIDs {
+		out[id] = faction
+	}
+	return out
+}
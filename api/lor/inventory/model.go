@@ -0,0 +1,7 @@
+package inventory
+
+type Card struct {
+	Code string `json:"code"`
+	// Count is sent as a string by Riot.
+	Count int `json:"count,string"`
+}
@@ -0,0 +1,13 @@
+package inventory
+
+import "leago/internal"
+
+type RegionClient struct {
+	client *internal.Client
+}
+
+func NewRegionClient(base *internal.Client) *RegionClient {
+	return &RegionClient{
+		base,
+	}
+}
@@ -0,0 +1,33 @@
+package inventory
+
+import (
+	"context"
+	"leago/internal"
+	"leago/options"
+)
+
+const (
+	MethodGetCards = "LorInventory.GetCards"
+)
+
+// GetCards returns the card collection of the player that authorized the RSO access token.
+func (rc *RegionClient) GetCards(
+	ctx context.Context,
+	accessToken string,
+	opts ...options.PublicOption,
+) ([]Card, error) {
+	endpoint := "/lor/inventory/v1/cards/me"
+
+	defaultOpts := []internal.RequestOption{
+		internal.WithApiMethod(MethodGetCards),
+		internal.WithBearerToken(accessToken),
+	}
+
+	uri := rc.client.GetURL(endpoint)
+	return internal.AuthRequest[[]Card](
+		ctx,
+		rc.client,
+		uri,
+		options.MergeOptions(defaultOpts, opts)...,
+	)
+}
@@ -0,0 +1,72 @@
+package inventory
+
+import (
+	"context"
+	"leago/internal"
+	"leago/internal/mock"
+	"leago/regions"
+	"log/slog"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCards(t *testing.T) {
+	tests := []struct {
+		name           string
+		statusCode     int
+		httpErr        error
+		responseBody   string
+		expectedResult []Card
+		wantErr        bool
+		wantRiotErr    bool
+	}{
+		{
+			name:         "riot error",
+			statusCode:   http.StatusUnauthorized,
+			responseBody: `{"status":{"status_code":401}}`,
+			wantErr:      true,
+			wantRiotErr:  true,
+		},
+		{
+			name:         "unmatched json",
+			statusCode:   http.StatusOK,
+			responseBody: `[{"code":"01DE001","count":"three"}]`,
+			wantErr:      true,
+			wantRiotErr:  false,
+		},
+		{
+			name:           "success",
+			statusCode:     http.StatusOK,
+			responseBody:   `[{"code":"01DE001","count":"3"},{"code":"01IO012","count":"1"}]`,
+			expectedResult: []Card{{Code: "01DE001", Count: 3}, {Code: "01IO012", Count: 1}},
+			wantErr:        false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDoer := mock.NewDefaultDoer(tt.statusCode, tt.responseBody, tt.httpErr)
+			baseClient := internal.NewHttpClient(mockDoer, slog.Default(), string(regions.RegionAmericas), "apiKey")
+			rc := NewRegionClient(baseClient)
+			resp, err := rc.GetCards(context.Background(), "accessToken")
+
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				if tt.wantRiotErr {
+					var rErr *internal.RiotError
+					assert.ErrorAs(t, err, &rErr)
+					assert.Equal(t, tt.statusCode, rErr.StatusCode)
+				}
+				return
+			}
+
+			require.Nil(t, err)
+			assert.Equal(t, "/lor/inventory/v1/cards/me", mockDoer.CapturedReq.URL.Path)
+			assert.Equal(t, "Bearer accessToken", mockDoer.CapturedReq.Header.Get("Authorization"))
+			assert.Equal(t, tt.expectedResult, resp)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package match
+
+import "leago/api/lor/deckcode"
+
+type (
+	Match struct {
+		Metadata Metadata `json:"metadata"`
+		Info     Info     `json:"info"`
+	}
+
+	Metadata struct {
+		DataVersion  string   `json:"data_version"`
+		MatchID      string   `json:"match_id"`
+		Participants []string `json:"participants"`
+	}
+
+	Info struct {
+		GameMode         string   `json:"game_mode"`
+		GameType         string   `json:"game_type"`
+		GameStartTimeUTC string   `json:"game_start_time_utc"`
+		GameVersion      string   `json:"game_version"`
+		Players          []Player `json:"players"`
+		TotalTurnCount   int      `json:"total_turn_count"`
+	}
+
+	Player struct {
+		PUUID       string   `json:"puuid"`
+		DeckID      string   `json:"deck_id"`
+		DeckCode    string   `json:"deck_code"`
+		Factions    []string `json:"factions"`
+		GameOutcome string   `json:"game_outcome"`
+		OrderOfPlay int      `json:"order_of_play"`
+	}
+)
+
+// Deck decodes the deck code played by the player.
+func (p Player) Deck() (deckcode.Deck, error) {
+	return deckcode.Decode(p.DeckCode)
+}
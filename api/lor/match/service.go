@@ -0,0 +1,62 @@
+package match
+
+import (
+	"context"
+	"fmt"
+	"leago/internal"
+	"leago/options"
+	"net/url"
+)
+
+const (
+	MethodGetMatchIDsByPUUID = "LorMatch.GetMatchIDsByPUUID"
+	MethodGetMatch           = "LorMatch.GetMatch"
+)
+
+// GetMatchIDsByPUUID returns the IDs of the latest matches played by the player.
+func (rc *RegionClient) GetMatchIDsByPUUID(
+	ctx context.Context,
+	puuid string,
+	opts ...options.PublicOption,
+) ([]string, error) {
+	endpoint := fmt.Sprintf(
+		"/lor/match/v1/matches/by-puuid/%s/ids",
+		url.PathEscape(puuid),
+	)
+
+	defaultOpts := []internal.RequestOption{
+		internal.WithApiMethod(MethodGetMatchIDsByPUUID),
+	}
+
+	uri := rc.client.GetURL(endpoint)
+	return internal.AuthRequest[[]string](
+		ctx,
+		rc.client,
+		uri,
+		options.MergeOptions(defaultOpts, opts)...,
+	)
+}
+
+// GetMatch returns the match got by the matchID.
+func (rc *RegionClient) GetMatch(
+	ctx context.Context,
+	matchID string,
+	opts ...options.PublicOption,
+) (Match, error) {
+	endpoint := fmt.Sprintf(
+		"/lor/match/v1/matches/%s",
+		url.PathEscape(matchID),
+	)
+
+	defaultOpts := []internal.RequestOption{
+		internal.WithApiMethod(MethodGetMatch),
+	}
+
+	uri := rc.client.GetURL(endpoint)
+	return internal.AuthRequest[Match](
+		ctx,
+		rc.client,
+		uri,
+		options.MergeOptions(defaultOpts, opts)...,
+	)
+}
@@ -0,0 +1,154 @@
+package match
+
+import (
+	"context"
+	"leago/internal"
+	"leago/internal/mock"
+	"leago/regions"
+	"log/slog"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMatchIDsByPUUID(t *testing.T) {
+	tests := []struct {
+		name           string
+		statusCode     int
+		httpErr        error
+		responseBody   string
+		expectedResult []string
+		wantErr        bool
+		wantRiotErr    bool
+	}{
+		{
+			name:         "riot error",
+			statusCode:   http.StatusForbidden,
+			responseBody: `{"status":{"status_code":403}}`,
+			wantErr:      true,
+			wantRiotErr:  true,
+		},
+		{
+			name:         "unmatched json",
+			statusCode:   http.StatusOK,
+			responseBody: `{"ids":[]}`,
+			wantErr:      true,
+			wantRiotErr:  false,
+		},
+		{
+			name:           "success",
+			statusCode:     http.StatusOK,
+			responseBody:   `["id1","id2"]`,
+			expectedResult: []string{"id1", "id2"},
+			wantErr:        false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDoer := mock.NewDefaultDoer(tt.statusCode, tt.responseBody, tt.httpErr)
+			baseClient := internal.NewHttpClient(mockDoer, slog.Default(), string(regions.RegionAmericas), "apiKey")
+			rc := NewRegionClient(baseClient)
+			resp, err := rc.GetMatchIDsByPUUID(context.Background(), "puuid")
+
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				if tt.wantRiotErr {
+					var rErr *internal.RiotError
+					assert.ErrorAs(t, err, &rErr)
+					assert.Equal(t, tt.statusCode, rErr.StatusCode)
+				}
+				return
+			}
+
+			require.Nil(t, err)
+			assert.Equal(t, "/lor/match/v1/matches/by-puuid/puuid/ids", mockDoer.CapturedReq.URL.Path)
+			assert.Equal(t, tt.expectedResult, resp)
+		})
+	}
+}
+
+func TestGetMatch(t *testing.T) {
+	tests := []struct {
+		name           string
+		statusCode     int
+		httpErr        error
+		responseBody   string
+		expectedResult Match
+		wantErr        bool
+		wantRiotErr    bool
+	}{
+		{
+			name:         "riot error",
+			statusCode:   http.StatusNotFound,
+			responseBody: `{"status":{"status_code":404}}`,
+			wantErr:      true,
+			wantRiotErr:  true,
+		},
+		{
+			name:         "unmatched json",
+			statusCode:   http.StatusOK,
+			responseBody: `{"info":{"players":{}}}`,
+			wantErr:      true,
+			wantRiotErr:  false,
+		},
+		{
+			name:         "success",
+			statusCode:   http.StatusOK,
+			responseBody: `{"metadata":{"data_version":"2","match_id":"id","participants":["puuid"]},"info":{"game_mode":"Constructed","game_type":"Ranked","game_start_time_utc":"2024-01-01T00:00:00.0000000+00:00","game_version":"live_5_0","players":[{"puuid":"puuid","deck_id":"deck","deck_code":"CEBAIAIFB4WDANQIAEAQGDAUDAQSIJZUAIAQCBIFAEAQCBAA","factions":["faction_ShadowIsles_Name","faction_Freljord_Name"],"game_outcome":"win","order_of_play":1}],"total_turn_count":20}}`,
+			expectedResult: Match{
+				Metadata: Metadata{DataVersion: "2", MatchID: "id", Participants: []string{"puuid"}},
+				Info: Info{
+					GameMode:         "Constructed",
+					GameType:         "Ranked",
+					GameStartTimeUTC: "2024-01-01T00:00:00.0000000+00:00",
+					GameVersion:      "live_5_0",
+					Players: []Player{{
+						PUUID:       "puuid",
+						DeckID:      "deck",
+						DeckCode:    "CEBAIAIFB4WDANQIAEAQGDAUDAQSIJZUAIAQCBIFAEAQCBAA",
+						Factions:    []string{"faction_ShadowIsles_Name", "faction_Freljord_Name"},
+						GameOutcome: "win",
+						OrderOfPlay: 1,
+					}},
+					TotalTurnCount: 20,
+				},
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDoer := mock.NewDefaultDoer(tt.statusCode, tt.responseBody, tt.httpErr)
+			baseClient := internal.NewHttpClient(mockDoer, slog.Default(), string(regions.RegionEurope), "apiKey")
+			rc := NewRegionClient(baseClient)
+			resp, err := rc.GetMatch(context.Background(), "id")
+
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				if tt.wantRiotErr {
+					var rErr *internal.RiotError
+					assert.ErrorAs(t, err, &rErr)
+					assert.Equal(t, tt.statusCode, rErr.StatusCode)
+				}
+				return
+			}
+
+			require.Nil(t, err)
+			assert.Equal(t, "/lor/match/v1/matches/id", mockDoer.CapturedReq.URL.Path)
+			assert.Equal(t, tt.expectedResult, resp)
+		})
+	}
+}
+
+func TestPlayerDeck(t *testing.T) {
+	deck, err := Player{DeckCode: "CEBAIAIFB4WDANQIAEAQGDAUDAQSIJZUAIAQCBIFAEAQCBAA"}.Deck()
+	require.Nil(t, err)
+	assert.Equal(t, 40, deck.Size())
+
+	_, err = Player{}.Deck()
+	assert.NotNil(t, err)
+}
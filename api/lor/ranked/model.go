@@ -0,0 +1,13 @@
+package ranked
+
+type (
+	Leaderboard struct {
+		Players []Player `json:"players"`
+	}
+
+	Player struct {
+		Name string `json:"name"`
+		Rank int    `json:"rank"`
+		LP   int    `json:"lp"`
+	}
+)
@@ -0,0 +1,31 @@
+package ranked
+
+import (
+	"context"
+	"leago/internal"
+	"leago/options"
+)
+
+const (
+	MethodGetLeaderboard = "LorRanked.GetLeaderboard"
+)
+
+// GetLeaderboard returns the players in Master tier of the region.
+func (rc *RegionClient) GetLeaderboard(
+	ctx context.Context,
+	opts ...options.PublicOption,
+) (Leaderboard, error) {
+	endpoint := "/lor/ranked/v1/leaderboards"
+
+	defaultOpts := []internal.RequestOption{
+		internal.WithApiMethod(MethodGetLeaderboard),
+	}
+
+	uri := rc.client.GetURL(endpoint)
+	return internal.AuthRequest[Leaderboard](
+		ctx,
+		rc.client,
+		uri,
+		options.MergeOptions(defaultOpts, opts)...,
+	)
+}
@@ -0,0 +1,40 @@
+package lor
+
+import (
+	"leago/api/lor/deck"
+	"leago/api/lor/inventory"
+	"leago/api/lor/match"
+	"leago/api/lor/ranked"
+	"leago/api/lor/status"
+	"leago/internal"
+	"leago/regions"
+	"log/slog"
+	"slices"
+)
+
+type RegionClient struct {
+	Deck      *deck.RegionClient
+	Inventory *inventory.RegionClient
+	Match     *match.RegionClient
+	Ranked    *ranked.RegionClient
+	Status    *status.RegionClient
+}
+
+func NewRegionClient(
+	client internal.Doer,
+	logger *slog.Logger,
+	region regions.Region,
+	apiKey string,
+	opts ...internal.ClientOption,
+) *RegionClient {
+	opts = append(slices.Clip(opts), internal.WithProduct(regions.ProductLoR))
+	baseClient := internal.NewHttpClient(client, logger, string(region), apiKey, opts...)
+	c := &RegionClient{
+		Deck:      deck.NewRegionClient(baseClient),
+		Inventory: inventory.NewRegionClient(baseClient),
+		Match:     match.NewRegionClient(baseClient),
+		Ranked:    ranked.NewRegionClient(baseClient),
+		Status:    status.NewRegionClient(baseClient),
+	}
+	return c
+}
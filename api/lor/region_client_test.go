@@ -0,0 +1,21 @@
+package lor
+
+import (
+	"leago/regions"
+	"log/slog"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRegionClient(t *testing.T) {
+	client := NewRegionClient(http.DefaultClient, slog.Default(), regions.RegionAmericas, "apiKey")
+	require.NotNil(t, client)
+
+	require.NotNil(t, client.Deck)
+	require.NotNil(t, client.Inventory)
+	require.NotNil(t, client.Match)
+	require.NotNil(t, client.Ranked)
+	require.NotNil(t, client.Status)
+}
@@ -0,0 +1,11 @@
+package status
+
+import "leago/api/lol/status"
+
+type (
+	// PlatformData of LoR-Status-V1, Riot serves it in the LoL status format.
+	PlatformData = status.PlatformData
+	Status       = status.Status
+	Content      = status.Content
+	Update       = status.Update
+)
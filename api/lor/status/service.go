@@ -0,0 +1,31 @@
+package status
+
+import (
+	"context"
+	"leago/internal"
+	"leago/options"
+)
+
+const (
+	MethodGetPlatformData = "LorStatus.GetPlatformData"
+)
+
+// GetPlatformData returns the current incidents and maintenances of the region.
+func (rc *RegionClient) GetPlatformData(
+	ctx context.Context,
+	opts ...options.PublicOption,
+) (PlatformData, error) {
+	endpoint := "/lor/status/v1/platform-data"
+
+	defaultOpts := []internal.RequestOption{
+		internal.WithApiMethod(MethodGetPlatformData),
+	}
+
+	uri := rc.client.GetURL(endpoint)
+	return internal.AuthRequest[PlatformData](
+		ctx,
+		rc.client,
+		uri,
+		options.MergeOptions(defaultOpts, opts)...,
+	)
+}
@@ -1,5 +1,7 @@
 package account
 
+import "leago/riotid"
+
 type (
 	Account struct {
 		Puuid    string `json:"puuid"`
@@ -32,3 +34,8 @@ const (
 	ActiveRegionLOL ActiveRegionGame = "lol"
 	ActiveRegionTFT ActiveRegionGame = "tft"
 )
+
+// RiotID returns the Riot ID of the account.
+func (a Account) RiotID() riotid.ID {
+	return riotid.ID{GameName: a.GameName, TagLine: a.TagLine}
+}
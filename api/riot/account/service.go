@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"leago/internal"
 	"leago/options"
+	"leago/riotid"
 	"net/url"
 )
 
@@ -13,6 +14,7 @@ const (
 	MethodGetActiveShardByPUUID  = "Account.GetActiveShardByPUUID"
 	MethodGetByPUUID             = "Account.GetByPUUID"
 	MethodGetByRiotID            = "Account.GetByRiotID"
+	MethodGetMe                  = "Account.GetMe"
 )
 
 // GetActiveRegion returns the user active region by their puuid and game.
@@ -91,18 +93,18 @@ func (rc *RegionClient) GetByPUUID(
 	)
 }
 
-// GetByRiotID returns the user account by their gamename and tagline.
+// GetByRiotID returns the user account by their Riot ID, see riotid.Parse.
+// The Riot ID is validated before sending the request.
 func (rc *RegionClient) GetByRiotID(
 	ctx context.Context,
-	gameName,
-	tagLine string,
+	id riotid.ID,
 	opts ...options.PublicOption,
 ) (Account, error) {
-	endpoint := fmt.Sprintf(
-		"/riot/account/v1/accounts/by-riot-id/%s/%s",
-		url.PathEscape(gameName),
-		url.PathEscape(tagLine),
-	)
+	if err := id.Validate(); err != nil {
+		return Account{}, err
+	}
+
+	endpoint := "/riot/account/v1/accounts/by-riot-id/" + id.Path()
 
 	defaultOpts := []internal.RequestOption{
 		internal.WithApiMethod(MethodGetByRiotID),
@@ -116,3 +118,25 @@ func (rc *RegionClient) GetByRiotID(
 		options.MergeOptions(defaultOpts, opts)...,
 	)
 }
+
+// GetMe returns the account of the player that authorized the RSO access token.
+func (rc *RegionClient) GetMe(
+	ctx context.Context,
+	accessToken string,
+	opts ...options.PublicOption,
+) (Account, error) {
+	endpoint := "/riot/account/v1/accounts/me"
+
+	defaultOpts := []internal.RequestOption{
+		internal.WithApiMethod(MethodGetMe),
+		internal.WithBearerToken(accessToken),
+	}
+
+	uri := rc.client.GetURL(endpoint)
+	return internal.AuthRequest[Account](
+		ctx,
+		rc.client,
+		uri,
+		options.MergeOptions(defaultOpts, opts)...,
+	)
+}
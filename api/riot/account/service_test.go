@@ -5,6 +5,7 @@ import (
 	"leago/internal"
 	"leago/internal/mock"
 	"leago/regions"
+	"leago/riotid"
 	"log/slog"
 	"net/http"
 	"testing"
@@ -124,18 +125,17 @@ func TestGetByRiotID(t *testing.T) {
 	tests := []struct {
 		name           string
 		statusCode     int
-		gameName       string
-		tagLine        string
+		id             riotid.ID
 		httpErr        error
 		responseBody   string
+		expectedPath   string
 		expectedResult Account
 		wantErr        bool
 		wantRiotErr    bool
 	}{
 		{
 			name:         "riot error",
-			gameName:     "TestPlayer",
-			tagLine:      "EUW",
+			id:           riotid.ID{GameName: "TestPlayer", TagLine: "EUW"},
 			statusCode:   http.StatusNotFound,
 			responseBody: `{"status":{"status_code":404}}`,
 			wantErr:      true,
@@ -143,19 +143,33 @@ func TestGetByRiotID(t *testing.T) {
 		},
 		{
 			name:         "invalid json",
-			gameName:     "TestPlayer",
-			tagLine:      "EUW",
+			id:           riotid.ID{GameName: "TestPlayer", TagLine: "EUW"},
 			statusCode:   http.StatusOK,
 			responseBody: `{"invalid json,,,,::"shouldbevalid"}`,
 			wantErr:      true,
 			wantRiotErr:  false,
 		},
+		{
+			name:        "invalid riot id",
+			id:          riotid.ID{GameName: "Test/Player", TagLine: "EUW"},
+			wantErr:     true,
+			wantRiotErr: false,
+		},
 		{
 			name:           "success",
-			gameName:       "TestPlayer",
-			tagLine:        "EUW",
+			id:             riotid.ID{GameName: "TestPlayer", TagLine: "EUW"},
+			statusCode:     http.StatusOK,
+			responseBody:   accountJSON,
+			expectedPath:   "/riot/account/v1/accounts/by-riot-id/TestPlayer/EUW",
+			expectedResult: expectedAccount,
+			wantErr:        false,
+		},
+		{
+			name:           "success with escaped riot id",
+			id:             riotid.ID{GameName: "Test Player 칼", TagLine: "EUW"},
 			statusCode:     http.StatusOK,
 			responseBody:   accountJSON,
+			expectedPath:   "/riot/account/v1/accounts/by-riot-id/Test%20Player%20%EC%B9%BC/EUW",
 			expectedResult: expectedAccount,
 			wantErr:        false,
 		},
@@ -168,7 +182,7 @@ func TestGetByRiotID(t *testing.T) {
 			baseClient := internal.NewHttpClient(mockDoer, slog.Default(), string(regions.RegionEurope), "apiKey")
 			rc := NewRegionClient(baseClient)
 
-			resp, err := rc.GetByRiotID(context.Background(), tt.gameName, tt.tagLine)
+			resp, err := rc.GetByRiotID(context.Background(), tt.id)
 
 			if tt.wantErr {
 				assert.NotNil(t, err)
@@ -182,7 +196,70 @@ func TestGetByRiotID(t *testing.T) {
 
 			require.Nil(t, err)
 			require.NotNil(t, resp)
+			assert.Equal(t, tt.expectedPath, mockDoer.CapturedReq.URL.EscapedPath())
 			assert.Equal(t, resp, tt.expectedResult)
+			assert.Equal(t, riotid.ID{GameName: "TestPlayer", TagLine: "EUW"}, resp.RiotID())
+		})
+	}
+}
+
+func TestGetMe(t *testing.T) {
+	tests := []struct {
+		name           string
+		statusCode     int
+		httpErr        error
+		responseBody   string
+		expectedResult Account
+		wantErr        bool
+		wantRiotErr    bool
+	}{
+		{
+			name:         "riot error",
+			statusCode:   http.StatusUnauthorized,
+			responseBody: `{"status":{"status_code":401}}`,
+			wantErr:      true,
+			wantRiotErr:  true,
+		},
+		{
+			name:         "invalid json",
+			statusCode:   http.StatusOK,
+			responseBody: `{"puuid":1}`,
+			wantErr:      true,
+			wantRiotErr:  false,
+		},
+		{
+			name:           "success",
+			statusCode:     http.StatusOK,
+			responseBody:   accountJSON,
+			expectedResult: expectedAccount,
+			wantErr:        false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDoer := mock.NewDefaultDoer(tt.statusCode, tt.responseBody, tt.httpErr)
+
+			baseClient := internal.NewHttpClient(mockDoer, slog.Default(), string(regions.RegionEurope), "apiKey")
+			rc := NewRegionClient(baseClient)
+
+			resp, err := rc.GetMe(context.Background(), "accessToken")
+
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				if tt.wantRiotErr {
+					var rErr *internal.RiotError
+					assert.ErrorAs(t, err, &rErr)
+					assert.Equal(t, tt.statusCode, rErr.StatusCode)
+				}
+				return
+			}
+
+			require.Nil(t, err)
+			assert.Equal(t, "/riot/account/v1/accounts/me", mockDoer.CapturedReq.URL.Path)
+			assert.Equal(t, "Bearer accessToken", mockDoer.CapturedReq.Header.Get("Authorization"))
+			assert.Empty(t, mockDoer.CapturedReq.Header.Values("X-Riot-Token"))
+			assert.Equal(t, tt.expectedResult, resp)
 		})
 	}
 }
@@ -11,8 +11,14 @@ type RegionClient struct {
 	Account *account.RegionClient
 }
 
-func NewRegionClient(client internal.Doer, logger *slog.Logger, region regions.Region, apiKey string) *RegionClient {
-	baseClient := internal.NewHttpClient(client, logger, string(region), apiKey)
+func NewRegionClient(
+	client internal.Doer,
+	logger *slog.Logger,
+	region regions.Region,
+	apiKey string,
+	opts ...internal.ClientOption,
+) *RegionClient {
+	baseClient := internal.NewHttpClient(client, logger, string(region), apiKey, opts...)
 	c := &RegionClient{
 		Account: account.NewRegionClient(baseClient),
 	}
@@ -0,0 +1,96 @@
+package league
+
+type (
+	// Entry is a player league entry, Hyper Roll entries use the rated tier and rating instead of tier and rank.
+	Entry struct {
+		PUUID        string      `json:"puuid"`
+		LeagueID     string      `json:"leagueId"`
+		SummonerID   string      `json:"summonerId"`
+		QueueType    Queue       `json:"queueType"`
+		RatedTier    RatedTier   `json:"ratedTier"`
+		RatedRating  int         `json:"ratedRating"`
+		Tier         Tier        `json:"tier"`
+		Rank         Division    `json:"rank"`
+		LeaguePoints int         `json:"leaguePoints"`
+		Wins         int         `json:"wins"`
+		Losses       int         `json:"losses"`
+		HotStreak    bool        `json:"hotStreak"`
+		Veteran      bool        `json:"veteran"`
+		FreshBlood   bool        `json:"freshBlood"`
+		Inactive     bool        `json:"inactive"`
+		MiniSeries   *MiniSeries `json:"miniSeries,omitempty"`
+	}
+
+	// League is a full league, like the Challenger, Grandmaster and Master apex tiers.
+	League struct {
+		LeagueID string `json:"leagueId"`
+		Entries  []Item `json:"entries"`
+		Tier     Tier   `json:"tier"`
+		Name     string `json:"name"`
+		Queue    Queue  `json:"queue"`
+	}
+
+	Item struct {
+		PUUID        string      `json:"puuid"`
+		SummonerID   string      `json:"summonerId"`
+		Rank         Division    `json:"rank"`
+		LeaguePoints int         `json:"leaguePoints"`
+		Wins         int         `json:"wins"`
+		Losses       int         `json:"losses"`
+		HotStreak    bool        `json:"hotStreak"`
+		Veteran      bool        `json:"veteran"`
+		FreshBlood   bool        `json:"freshBlood"`
+		Inactive     bool        `json:"inactive"`
+		MiniSeries   *MiniSeries `json:"miniSeries,omitempty"`
+	}
+
+	MiniSeries struct {
+		Losses   int    `json:"losses"`
+		Progress string `json:"progress"`
+		Target   int    `json:"target"`
+		Wins     int    `json:"wins"`
+	}
+
+	// RatedLadderEntry is a player on the top of a rated ladder.
+	RatedLadderEntry struct {
+		PUUID                        string    `json:"puuid"`
+		RatedTier                    RatedTier `json:"ratedTier"`
+		RatedRating                  int       `json:"ratedRating"`
+		Wins                         int       `json:"wins"`
+		PreviousUpdateLadderPosition int       `json:"previousUpdateLadderPosition"`
+	}
+
+	Queue     string
+	Tier      string
+	Division  string
+	RatedTier string
+)
+
+const (
+	QueueRanked      Queue = "RANKED_TFT"
+	QueueDoubleUp    Queue = "RANKED_TFT_DOUBLE_UP"
+	QueueHyperRoll   Queue = "RANKED_TFT_TURBO"
+	QueueRankedPairs Queue = "RANKED_TFT_PAIRS"
+
+	TierChallenger  Tier = "CHALLENGER"
+	TierGrandmaster Tier = "GRANDMASTER"
+	TierMaster      Tier = "MASTER"
+	TierDiamond     Tier = "DIAMOND"
+	TierEmerald     Tier = "EMERALD"
+	TierPlatinum    Tier = "PLATINUM"
+	TierGold        Tier = "GOLD"
+	TierSilver      Tier = "SILVER"
+	TierBronze      Tier = "BRONZE"
+	TierIron        Tier = "IRON"
+
+	DivisionI   Division = "I"
+	DivisionII  Division = "II"
+	DivisionIII Division = "III"
+	DivisionIV  Division = "IV"
+
+	RatedTierOrange RatedTier = "ORANGE"
+	RatedTierPurple RatedTier = "PURPLE"
+	RatedTierBlue   RatedTier = "BLUE"
+	RatedTierGreen  RatedTier = "GREEN"
+	RatedTierGray   RatedTier = "GRAY"
+)
@@ -0,0 +1,27 @@
+package league
+
+import (
+	"leago/internal"
+	"strconv"
+)
+
+type GetLeagueEntriesOption internal.RequestOption
+
+// WithQueue sets the queue of the entries (Default RANKED_TFT).
+func WithQueue(queue Queue) GetLeagueEntriesOption {
+	return GetLeagueEntriesOption(internal.WithParam("queue", string(queue)))
+}
+
+// WithPage sets the page of the entries, starting at 1.
+func WithPage(page int) GetLeagueEntriesOption {
+	return GetLeagueEntriesOption(internal.WithParam("page", strconv.Itoa(page)))
+}
+
+// getLeagueEntriesOptionsToRequestOptions converts the array of options into internal request options.
+func getLeagueEntriesOptionsToRequestOptions(opts []GetLeagueEntriesOption) []internal.RequestOption {
+	out := make([]internal.RequestOption, len(opts))
+	for i, o := range opts {
+		out[i] = internal.RequestOption(o)
+	}
+	return out
+}
@@ -0,0 +1,170 @@
+package league
+
+import (
+	"context"
+	"fmt"
+	"leago/internal"
+	"leago/options"
+	"net/url"
+)
+
+const (
+	MethodGetLeagueEntriesByPUUID = "TftLeague.GetLeagueEntriesByPUUID"
+	MethodGetChallengerLeague     = "TftLeague.GetChallengerLeague"
+	MethodGetGrandmasterLeague    = "TftLeague.GetGrandmasterLeague"
+	MethodGetMasterLeague         = "TftLeague.GetMasterLeague"
+	MethodGetLeagueEntries        = "TftLeague.GetLeagueEntries"
+	MethodGetLeagueByID           = "TftLeague.GetLeagueByID"
+	MethodGetTopRatedLadder       = "TftLeague.GetTopRatedLadder"
+)
+
+// GetLeagueEntriesByPUUID returns the league entries of the player on each TFT queue.
+func (pc *PlatformClient) GetLeagueEntriesByPUUID(
+	ctx context.Context,
+	puuid string,
+	opts ...options.PublicOption,
+) ([]Entry, error) {
+	endpoint := fmt.Sprintf(
+		"/tft/league/v1/by-puuid/%s",
+		url.PathEscape(puuid),
+	)
+
+	defaultOpts := []internal.RequestOption{
+		internal.WithApiMethod(MethodGetLeagueEntriesByPUUID),
+	}
+
+	uri := pc.client.GetURL(endpoint)
+	return internal.AuthRequest[[]Entry](
+		ctx,
+		pc.client,
+		uri,
+		options.MergeOptions(defaultOpts, opts)...,
+	)
+}
+
+// GetChallengerLeague returns the challenger league of the queue.
+func (pc *PlatformClient) GetChallengerLeague(
+	ctx context.Context,
+	queue Queue,
+	opts ...options.PublicOption,
+) (League, error) {
+	return pc.getApexLeague(ctx, "challenger", MethodGetChallengerLeague, queue, opts)
+}
+
+// GetGrandmasterLeague returns the grandmaster league of the queue.
+func (pc *PlatformClient) GetGrandmasterLeague(
+	ctx context.Context,
+	queue Queue,
+	opts ...options.PublicOption,
+) (League, error) {
+	return pc.getApexLeague(ctx, "grandmaster", MethodGetGrandmasterLeague, queue, opts)
+}
+
+// GetMasterLeague returns the master league of the queue.
+func (pc *PlatformClient) GetMasterLeague(
+	ctx context.Context,
+	queue Queue,
+	opts ...options.PublicOption,
+) (League, error) {
+	return pc.getApexLeague(ctx, "master", MethodGetMasterLeague, queue, opts)
+}
+
+// getApexLeague returns one of the apex tiers leagues, they only differ by path.
+func (pc *PlatformClient) getApexLeague(
+	ctx context.Context,
+	tier string,
+	apiMethod string,
+	queue Queue,
+	opts []options.PublicOption,
+) (League, error) {
+	endpoint := "/tft/league/v1/" + tier
+
+	defaultOpts := []internal.RequestOption{
+		internal.WithApiMethod(apiMethod),
+		internal.WithParam("queue", string(queue)),
+	}
+
+	uri := pc.client.GetURL(endpoint)
+	return internal.AuthRequest[League](
+		ctx,
+		pc.client,
+		uri,
+		options.MergeOptions(defaultOpts, opts)...,
+	)
+}
+
+// GetLeagueEntries returns the entries of the tier and division, below Master.
+func (pc *PlatformClient) GetLeagueEntries(
+	ctx context.Context,
+	tier Tier,
+	division Division,
+	endpointOpts []GetLeagueEntriesOption,
+	opts ...options.PublicOption,
+) ([]Entry, error) {
+	endpoint := fmt.Sprintf(
+		"/tft/league/v1/entries/%s/%s",
+		tier,
+		division,
+	)
+
+	defaultOpts := append(
+		[]internal.RequestOption{internal.WithApiMethod(MethodGetLeagueEntries)},
+		getLeagueEntriesOptionsToRequestOptions(endpointOpts)...,
+	)
+
+	uri := pc.client.GetURL(endpoint)
+	return internal.AuthRequest[[]Entry](
+		ctx,
+		pc.client,
+		uri,
+		options.MergeOptions(defaultOpts, opts)...,
+	)
+}
+
+// GetLeagueByID returns the league got by its ID.
+func (pc *PlatformClient) GetLeagueByID(
+	ctx context.Context,
+	leagueID string,
+	opts ...options.PublicOption,
+) (League, error) {
+	endpoint := fmt.Sprintf(
+		"/tft/league/v1/leagues/%s",
+		url.PathEscape(leagueID),
+	)
+
+	defaultOpts := []internal.RequestOption{
+		internal.WithApiMethod(MethodGetLeagueByID),
+	}
+
+	uri := pc.client.GetURL(endpoint)
+	return internal.AuthRequest[League](
+		ctx,
+		pc.client,
+		uri,
+		options.MergeOptions(defaultOpts, opts)...,
+	)
+}
+
+// GetTopRatedLadder returns the top of a rated ladder, like Hyper Roll (RANKED_TFT_TURBO).
+func (pc *PlatformClient) GetTopRatedLadder(
+	ctx context.Context,
+	queue Queue,
+	opts ...options.PublicOption,
+) ([]RatedLadderEntry, error) {
+	endpoint := fmt.Sprintf(
+		"/tft/league/v1/rated-ladders/%s/top",
+		queue,
+	)
+
+	defaultOpts := []internal.RequestOption{
+		internal.WithApiMethod(MethodGetTopRatedLadder),
+	}
+
+	uri := pc.client.GetURL(endpoint)
+	return internal.AuthRequest[[]RatedLadderEntry](
+		ctx,
+		pc.client,
+		uri,
+		options.MergeOptions(defaultOpts, opts)...,
+	)
+}
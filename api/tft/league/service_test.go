@@ -0,0 +1,282 @@
+package league
+
+import (
+	"context"
+	"leago/internal"
+	"leago/internal/mock"
+	"leago/regions"
+	"log/slog"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetLeagueEntriesByPUUID(t *testing.T) {
+	tests := []struct {
+		name           string
+		statusCode     int
+		puuid          string
+		httpErr        error
+		responseBody   string
+		expectedResult []Entry
+		wantErr        bool
+		wantRiotErr    bool
+	}{
+		{
+			name:         "riot error",
+			puuid:        "test-puuid",
+			statusCode:   http.StatusNotFound,
+			responseBody: `{"status":{"status_code":404}}`,
+			wantErr:      true,
+			wantRiotErr:  true,
+		},
+		{
+			name:         "unmatched json",
+			puuid:        "test-puuid",
+			statusCode:   http.StatusOK,
+			responseBody: `{"puuid":"test-puuid"}`,
+			wantErr:      true,
+			wantRiotErr:  false,
+		},
+		{
+			name:       "success",
+			puuid:      "test-puuid",
+			statusCode: http.StatusOK,
+			responseBody: `[
+				{"puuid":"test-puuid","leagueId":"league-1","queueType":"RANKED_TFT","tier":"GOLD","rank":"II","leaguePoints":50,"wins":10,"losses":12},
+				{"puuid":"test-puuid","queueType":"RANKED_TFT_TURBO","ratedTier":"PURPLE","ratedRating":3000,"wins":4,"losses":6}
+			]`,
+			expectedResult: []Entry{
+				{
+					PUUID:        "test-puuid",
+					LeagueID:     "league-1",
+					QueueType:    QueueRanked,
+					Tier:         TierGold,
+					Rank:         DivisionII,
+					LeaguePoints: 50,
+					Wins:         10,
+					Losses:       12,
+				},
+				{
+					PUUID:       "test-puuid",
+					QueueType:   QueueHyperRoll,
+					RatedTier:   RatedTierPurple,
+					RatedRating: 3000,
+					Wins:        4,
+					Losses:      6,
+				},
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDoer := mock.NewDefaultDoer(tt.statusCode, tt.responseBody, tt.httpErr)
+			baseClient := internal.NewHttpClient(mockDoer, slog.Default(), string(regions.PlatformBR1), "apiKey")
+			pc := NewPlatformClient(baseClient)
+			resp, err := pc.GetLeagueEntriesByPUUID(context.Background(), tt.puuid)
+
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				if tt.wantRiotErr {
+					var rErr *internal.RiotError
+					assert.ErrorAs(t, err, &rErr)
+					assert.Equal(t, tt.statusCode, rErr.StatusCode)
+				}
+				return
+			}
+
+			require.Nil(t, err)
+			assert.Equal(t, tt.expectedResult, resp)
+		})
+	}
+}
+
+func TestGetApexLeagues(t *testing.T) {
+	responseBody := `{
+		"leagueId":"league-1",
+		"entries":[{"puuid":"puuid-1","rank":"I","leaguePoints":1200,"wins":100,"losses":80}],
+		"tier":"CHALLENGER",
+		"name":"apex",
+		"queue":"RANKED_TFT_DOUBLE_UP"
+	}`
+	expected := League{
+		LeagueID: "league-1",
+		Entries:  []Item{{PUUID: "puuid-1", Rank: DivisionI, LeaguePoints: 1200, Wins: 100, Losses: 80}},
+		Tier:     TierChallenger,
+		Name:     "apex",
+		Queue:    QueueDoubleUp,
+	}
+
+	tests := []struct {
+		name     string
+		call     func(pc *PlatformClient) (League, error)
+		wantPath string
+	}{
+		{
+			name: "challenger",
+			call: func(pc *PlatformClient) (League, error) {
+				return pc.GetChallengerLeague(context.Background(), QueueDoubleUp)
+			},
+			wantPath: "/tft/league/v1/challenger",
+		},
+		{
+			name: "grandmaster",
+			call: func(pc *PlatformClient) (League, error) {
+				return pc.GetGrandmasterLeague(context.Background(), QueueDoubleUp)
+			},
+			wantPath: "/tft/league/v1/grandmaster",
+		},
+		{
+			name: "master",
+			call: func(pc *PlatformClient) (League, error) {
+				return pc.GetMasterLeague(context.Background(), QueueDoubleUp)
+			},
+			wantPath: "/tft/league/v1/master",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDoer := mock.NewDefaultDoer(http.StatusOK, responseBody, nil)
+			baseClient := internal.NewHttpClient(mockDoer, slog.Default(), string(regions.PlatformBR1), "apiKey")
+			resp, err := tt.call(NewPlatformClient(baseClient))
+
+			require.Nil(t, err)
+			assert.Equal(t, tt.wantPath, mockDoer.CapturedReq.URL.Path)
+			assert.Equal(t, string(QueueDoubleUp), mockDoer.CapturedReq.URL.Query().Get("queue"))
+			assert.Equal(t, expected, resp)
+		})
+	}
+}
+
+func TestGetLeagueEntries(t *testing.T) {
+	tests := []struct {
+		name           string
+		statusCode     int
+		httpErr        error
+		responseBody   string
+		expectedResult []Entry
+		wantErr        bool
+		wantRiotErr    bool
+	}{
+		{
+			name:         "riot error",
+			statusCode:   http.StatusBadRequest,
+			responseBody: `{"status":{"status_code":400}}`,
+			wantErr:      true,
+			wantRiotErr:  true,
+		},
+		{
+			name:           "success",
+			statusCode:     http.StatusOK,
+			responseBody:   `[{"puuid":"puuid-1","queueType":"RANKED_TFT_DOUBLE_UP","tier":"DIAMOND","rank":"IV"}]`,
+			expectedResult: []Entry{{PUUID: "puuid-1", QueueType: QueueDoubleUp, Tier: TierDiamond, Rank: DivisionIV}},
+			wantErr:        false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDoer := mock.NewDefaultDoer(tt.statusCode, tt.responseBody, tt.httpErr)
+			baseClient := internal.NewHttpClient(mockDoer, slog.Default(), string(regions.PlatformBR1), "apiKey")
+			pc := NewPlatformClient(baseClient)
+			resp, err := pc.GetLeagueEntries(
+				context.Background(),
+				TierDiamond,
+				DivisionIV,
+				[]GetLeagueEntriesOption{WithQueue(QueueDoubleUp), WithPage(2)},
+			)
+
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				if tt.wantRiotErr {
+					var rErr *internal.RiotError
+					assert.ErrorAs(t, err, &rErr)
+					assert.Equal(t, tt.statusCode, rErr.StatusCode)
+				}
+				return
+			}
+
+			require.Nil(t, err)
+			assert.Equal(t, "/tft/league/v1/entries/DIAMOND/IV", mockDoer.CapturedReq.URL.Path)
+			assert.Equal(t, "RANKED_TFT_DOUBLE_UP", mockDoer.CapturedReq.URL.Query().Get("queue"))
+			assert.Equal(t, "2", mockDoer.CapturedReq.URL.Query().Get("page"))
+			assert.Equal(t, tt.expectedResult, resp)
+		})
+	}
+}
+
+func TestGetLeagueByID(t *testing.T) {
+	mockDoer := mock.NewDefaultDoer(http.StatusOK, `{"leagueId":"league-1","tier":"GOLD","queue":"RANKED_TFT"}`, nil)
+	baseClient := internal.NewHttpClient(mockDoer, slog.Default(), string(regions.PlatformBR1), "apiKey")
+	pc := NewPlatformClient(baseClient)
+
+	resp, err := pc.GetLeagueByID(context.Background(), "league-1")
+	require.Nil(t, err)
+
+	assert.Equal(t, "/tft/league/v1/leagues/league-1", mockDoer.CapturedReq.URL.Path)
+	assert.Equal(t, League{LeagueID: "league-1", Tier: TierGold, Queue: QueueRanked}, resp)
+}
+
+func TestGetTopRatedLadder(t *testing.T) {
+	tests := []struct {
+		name           string
+		statusCode     int
+		httpErr        error
+		responseBody   string
+		expectedResult []RatedLadderEntry
+		wantErr        bool
+		wantRiotErr    bool
+	}{
+		{
+			name:         "riot error",
+			statusCode:   http.StatusBadRequest,
+			responseBody: `{"status":{"status_code":400}}`,
+			wantErr:      true,
+			wantRiotErr:  true,
+		},
+		{
+			name:         "invalid json",
+			statusCode:   http.StatusOK,
+			responseBody: `[{"puuid":`,
+			wantErr:      true,
+			wantRiotErr:  false,
+		},
+		{
+			name:         "success",
+			statusCode:   http.StatusOK,
+			responseBody: `[{"puuid":"puuid-1","ratedTier":"ORANGE","ratedRating":6000,"wins":120,"previousUpdateLadderPosition":2}]`,
+			expectedResult: []RatedLadderEntry{
+				{PUUID: "puuid-1", RatedTier: RatedTierOrange, RatedRating: 6000, Wins: 120, PreviousUpdateLadderPosition: 2},
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDoer := mock.NewDefaultDoer(tt.statusCode, tt.responseBody, tt.httpErr)
+			baseClient := internal.NewHttpClient(mockDoer, slog.Default(), string(regions.PlatformBR1), "apiKey")
+			pc := NewPlatformClient(baseClient)
+			resp, err := pc.GetTopRatedLadder(context.Background(), QueueHyperRoll)
+
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				if tt.wantRiotErr {
+					var rErr *internal.RiotError
+					assert.ErrorAs(t, err, &rErr)
+					assert.Equal(t, tt.statusCode, rErr.StatusCode)
+				}
+				return
+			}
+
+			require.Nil(t, err)
+			assert.Equal(t, "/tft/league/v1/rated-ladders/RANKED_TFT_TURBO/top", mockDoer.CapturedReq.URL.Path)
+			assert.Equal(t, tt.expectedResult, resp)
+		})
+	}
+}
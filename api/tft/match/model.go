@@ -0,0 +1,74 @@
+package match
+
+type (
+	Match struct {
+		Metadata Metadata `json:"metadata"`
+		Info     Info     `json:"info"`
+	}
+
+	Metadata struct {
+		DataVersion  string   `json:"data_version"`
+		MatchID      string   `json:"match_id"`
+		Participants []string `json:"participants"`
+	}
+
+	Info struct {
+		EndOfGameResult string        `json:"endOfGameResult"`
+		GameCreation    int64         `json:"gameCreation"`
+		GameID          int64         `json:"gameId"`
+		GameDatetime    int64         `json:"game_datetime"`
+		GameLength      float64       `json:"game_length"`
+		GameVersion     string        `json:"game_version"`
+		MapID           int           `json:"mapId"`
+		Participants    []Participant `json:"participants"`
+		QueueID         int           `json:"queueId"`
+		TftGameType     string        `json:"tft_game_type"`
+		TftSetCoreName  string        `json:"tft_set_core_name"`
+		TftSetNumber    int           `json:"tft_set_number"`
+	}
+
+	Participant struct {
+		Augments             []string  `json:"augments"`
+		Companion            Companion `json:"companion"`
+		GoldLeft             int       `json:"gold_left"`
+		LastRound            int       `json:"last_round"`
+		Level                int       `json:"level"`
+		PartnerGroupID       int       `json:"partner_group_id"`
+		Placement            int       `json:"placement"`
+		PlayersEliminated    int       `json:"players_eliminated"`
+		PUUID                string    `json:"puuid"`
+		RiotIDGameName       string    `json:"riotIdGameName"`
+		RiotIDTagline        string    `json:"riotIdTagline"`
+		TimeEliminated       float64   `json:"time_eliminated"`
+		TotalDamageToPlayers int       `json:"total_damage_to_players"`
+		Traits               []Trait   `json:"traits"`
+		Units                []Unit    `json:"units"`
+		Win                  bool      `json:"win"`
+	}
+
+	// Companion is the little legend used by the player.
+	Companion struct {
+		ContentID string `json:"content_ID"`
+		ItemID    int    `json:"item_ID"`
+		SkinID    int    `json:"skin_ID"`
+		Species   string `json:"species"`
+	}
+
+	Trait struct {
+		Name     string `json:"name"`
+		NumUnits int    `json:"num_units"`
+		// Style is the trait rank shown on the client: 0 no style, 1 bronze, 2 silver, 3 gold and 4 chromatic.
+		Style       int `json:"style"`
+		TierCurrent int `json:"tier_current"`
+		TierTotal   int `json:"tier_total"`
+	}
+
+	Unit struct {
+		CharacterID string   `json:"character_id"`
+		ItemNames   []string `json:"itemNames"`
+		Name        string   `json:"name"`
+		Rarity      int      `json:"rarity"`
+		// Tier is the star level of the unit.
+		Tier int `json:"tier"`
+	}
+)
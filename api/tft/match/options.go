@@ -0,0 +1,38 @@
+package match
+
+import (
+	"leago/internal"
+	"strconv"
+	"time"
+)
+
+type GetMatchIDsOption internal.RequestOption
+
+// WithStart sets the start index of the returned match IDs (Default 0).
+func WithStart(start int) GetMatchIDsOption {
+	return GetMatchIDsOption(internal.WithParam("start", strconv.Itoa(start)))
+}
+
+// WithCount sets the number of match IDs returned, (Default 20).
+func WithCount(count int) GetMatchIDsOption {
+	return GetMatchIDsOption(internal.WithParam("count", strconv.Itoa(count)))
+}
+
+// WithStartTime filters the match IDs to matches played after the time.
+func WithStartTime(startTime time.Time) GetMatchIDsOption {
+	return GetMatchIDsOption(internal.WithParam("startTime", strconv.FormatInt(startTime.Unix(), 10)))
+}
+
+// WithEndTime filters the match IDs to matches played before the time.
+func WithEndTime(endTime time.Time) GetMatchIDsOption {
+	return GetMatchIDsOption(internal.WithParam("endTime", strconv.FormatInt(endTime.Unix(), 10)))
+}
+
+// getMatchIDsOptionsToRequestOptions converts the array of options into internal request options.
+func getMatchIDsOptionsToRequestOptions(opts []GetMatchIDsOption) []internal.RequestOption {
+	out := make([]internal.RequestOption, len(opts))
+	for i, o := range opts {
+		out[i] = internal.RequestOption(o)
+	}
+	return out
+}
@@ -0,0 +1,64 @@
+package match
+
+import (
+	"context"
+	"fmt"
+	"leago/internal"
+	"leago/options"
+	"net/url"
+)
+
+const (
+	MethodGetMatchIDsByPUUID = "TftMatch.GetMatchIDsByPUUID"
+	MethodGetMatch           = "TftMatch.GetMatch"
+)
+
+// GetMatchIDsByPUUID returns a list of match IDs played by the player, most recent first.
+func (rc *RegionClient) GetMatchIDsByPUUID(
+	ctx context.Context,
+	puuid string,
+	endpointOpts []GetMatchIDsOption,
+	opts ...options.PublicOption,
+) ([]string, error) {
+	endpoint := fmt.Sprintf(
+		"/tft/match/v1/matches/by-puuid/%s/ids",
+		url.PathEscape(puuid),
+	)
+
+	defaultOpts := append(
+		[]internal.RequestOption{internal.WithApiMethod(MethodGetMatchIDsByPUUID)},
+		getMatchIDsOptionsToRequestOptions(endpointOpts)...,
+	)
+
+	uri := rc.client.GetURL(endpoint)
+	return internal.AuthRequest[[]string](
+		ctx,
+		rc.client,
+		uri,
+		options.MergeOptions(defaultOpts, opts)...,
+	)
+}
+
+// GetMatch returns the match details got by the matchID, like "NA1_4900000000".
+func (rc *RegionClient) GetMatch(
+	ctx context.Context,
+	matchID string,
+	opts ...options.PublicOption,
+) (Match, error) {
+	endpoint := fmt.Sprintf(
+		"/tft/match/v1/matches/%s",
+		url.PathEscape(matchID),
+	)
+
+	defaultOpts := []internal.RequestOption{
+		internal.WithApiMethod(MethodGetMatch),
+	}
+
+	uri := rc.client.GetURL(endpoint)
+	return internal.AuthRequest[Match](
+		ctx,
+		rc.client,
+		uri,
+		options.MergeOptions(defaultOpts, opts)...,
+	)
+}
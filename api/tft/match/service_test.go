@@ -0,0 +1,215 @@
+package match
+
+import (
+	"context"
+	"leago/internal"
+	"leago/internal/mock"
+	"leago/regions"
+	"log/slog"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMatchIDsByPUUID(t *testing.T) {
+	tests := []struct {
+		name           string
+		statusCode     int
+		puuid          string
+		httpErr        error
+		responseBody   string
+		expectedResult []string
+		wantErr        bool
+		wantRiotErr    bool
+	}{
+		{
+			name:         "riot error",
+			puuid:        "test-puuid",
+			statusCode:   http.StatusBadRequest,
+			responseBody: `{"status":{"status_code":400}}`,
+			wantErr:      true,
+			wantRiotErr:  true,
+		},
+		{
+			name:         "unmatched json",
+			puuid:        "test-puuid",
+			statusCode:   http.StatusOK,
+			responseBody: `{"ids":[]}`,
+			wantErr:      true,
+			wantRiotErr:  false,
+		},
+		{
+			name:           "success",
+			puuid:          "test-puuid",
+			statusCode:     http.StatusOK,
+			responseBody:   `["BR1_3000000001"]`,
+			expectedResult: []string{"BR1_3000000001"},
+			wantErr:        false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDoer := mock.NewDefaultDoer(tt.statusCode, tt.responseBody, tt.httpErr)
+			baseClient := internal.NewHttpClient(mockDoer, slog.Default(), string(regions.RegionAmericas), "apiKey")
+			rc := NewRegionClient(baseClient)
+
+			startTime := time.Unix(1700000000, 0)
+			resp, err := rc.GetMatchIDsByPUUID(
+				context.Background(),
+				tt.puuid,
+				[]GetMatchIDsOption{WithStart(0), WithCount(10), WithStartTime(startTime), WithEndTime(startTime.Add(time.Hour))},
+			)
+
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				if tt.wantRiotErr {
+					var rErr *internal.RiotError
+					assert.ErrorAs(t, err, &rErr)
+					assert.Equal(t, tt.statusCode, rErr.StatusCode)
+				}
+				return
+			}
+
+			require.Nil(t, err)
+
+			query := mockDoer.CapturedReq.URL.Query()
+			assert.Equal(t, "/tft/match/v1/matches/by-puuid/test-puuid/ids", mockDoer.CapturedReq.URL.Path)
+			assert.Equal(t, "0", query.Get("start"))
+			assert.Equal(t, "10", query.Get("count"))
+			assert.Equal(t, "1700000000", query.Get("startTime"))
+			assert.Equal(t, "1700003600", query.Get("endTime"))
+			assert.Equal(t, tt.expectedResult, resp)
+		})
+	}
+}
+
+func TestGetMatch(t *testing.T) {
+	tests := []struct {
+		name           string
+		statusCode     int
+		matchID        string
+		httpErr        error
+		responseBody   string
+		expectedResult Match
+		wantErr        bool
+		wantRiotErr    bool
+	}{
+		{
+			name:         "riot error",
+			matchID:      "BR1_3000000001",
+			statusCode:   http.StatusNotFound,
+			responseBody: `{"status":{"status_code":404}}`,
+			wantErr:      true,
+			wantRiotErr:  true,
+		},
+		{
+			name:         "invalid json",
+			matchID:      "BR1_3000000001",
+			statusCode:   http.StatusOK,
+			responseBody: `{"info":`,
+			wantErr:      true,
+			wantRiotErr:  false,
+		},
+		{
+			name:       "success",
+			matchID:    "BR1_3000000001",
+			statusCode: http.StatusOK,
+			responseBody: `{
+				"metadata":{"data_version":"6","match_id":"BR1_3000000001","participants":["puuid-1"]},
+				"info":{
+					"endOfGameResult":"GameComplete",
+					"gameCreation":1700000000000,
+					"gameId":3000000001,
+					"game_datetime":1700002000000,
+					"game_length":2100.5,
+					"game_version":"Version 14.1",
+					"mapId":22,
+					"participants":[{
+						"augments":["TFT9_Augment_Example"],
+						"companion":{"content_ID":"content","item_ID":1,"skin_ID":2,"species":"PetTFTAvatar"},
+						"gold_left":3,
+						"last_round":33,
+						"level":9,
+						"placement":1,
+						"players_eliminated":2,
+						"puuid":"puuid-1",
+						"riotIdGameName":"Player",
+						"riotIdTagline":"BR1",
+						"time_eliminated":2090.1,
+						"total_damage_to_players":150,
+						"traits":[{"name":"Set9_Bruiser","num_units":4,"style":2,"tier_current":2,"tier_total":3}],
+						"units":[{"character_id":"TFT9_Sett","itemNames":["TFT_Item_WarmogsArmor"],"name":"","rarity":4,"tier":2}],
+						"win":true
+					}],
+					"queueId":1100,
+					"tft_game_type":"standard",
+					"tft_set_core_name":"TFTSet9",
+					"tft_set_number":9
+				}
+			}`,
+			expectedResult: Match{
+				Metadata: Metadata{DataVersion: "6", MatchID: "BR1_3000000001", Participants: []string{"puuid-1"}},
+				Info: Info{
+					EndOfGameResult: "GameComplete",
+					GameCreation:    1700000000000,
+					GameID:          3000000001,
+					GameDatetime:    1700002000000,
+					GameLength:      2100.5,
+					GameVersion:     "Version 14.1",
+					MapID:           22,
+					Participants: []Participant{
+						{
+							Augments:             []string{"TFT9_Augment_Example"},
+							Companion:            Companion{ContentID: "content", ItemID: 1, SkinID: 2, Species: "PetTFTAvatar"},
+							GoldLeft:             3,
+							LastRound:            33,
+							Level:                9,
+							Placement:            1,
+							PlayersEliminated:    2,
+							PUUID:                "puuid-1",
+							RiotIDGameName:       "Player",
+							RiotIDTagline:        "BR1",
+							TimeEliminated:       2090.1,
+							TotalDamageToPlayers: 150,
+							Traits:               []Trait{{Name: "Set9_Bruiser", NumUnits: 4, Style: 2, TierCurrent: 2, TierTotal: 3}},
+							Units:                []Unit{{CharacterID: "TFT9_Sett", ItemNames: []string{"TFT_Item_WarmogsArmor"}, Rarity: 4, Tier: 2}},
+							Win:                  true,
+						},
+					},
+					QueueID:        1100,
+					TftGameType:    "standard",
+					TftSetCoreName: "TFTSet9",
+					TftSetNumber:   9,
+				},
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDoer := mock.NewDefaultDoer(tt.statusCode, tt.responseBody, tt.httpErr)
+			baseClient := internal.NewHttpClient(mockDoer, slog.Default(), string(regions.RegionAmericas), "apiKey")
+			rc := NewRegionClient(baseClient)
+			resp, err := rc.GetMatch(context.Background(), tt.matchID)
+
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				if tt.wantRiotErr {
+					var rErr *internal.RiotError
+					assert.ErrorAs(t, err, &rErr)
+					assert.Equal(t, tt.statusCode, rErr.StatusCode)
+				}
+				return
+			}
+
+			require.Nil(t, err)
+			assert.Equal(t, "/tft/match/v1/matches/BR1_3000000001", mockDoer.CapturedReq.URL.Path)
+			assert.Equal(t, tt.expectedResult, resp)
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package tft
+
+import (
+	"leago/api/tft/league"
+	"leago/api/tft/spectator"
+	"leago/api/tft/status"
+	"leago/api/tft/summoner"
+	"leago/internal"
+	"leago/regions"
+	"log/slog"
+	"slices"
+)
+
+type PlatformClient struct {
+	League    *league.PlatformClient
+	Spectator *spectator.PlatformClient
+	Status    *status.PlatformClient
+	Summoner  *summoner.PlatformClient
+}
+
+func NewPlatformClient(
+	client internal.Doer,
+	logger *slog.Logger,
+	region regions.Platform,
+	apiKey string,
+	opts ...internal.ClientOption,
+) *PlatformClient {
+	opts = append(slices.Clip(opts), internal.WithProduct(regions.ProductTFT))
+	baseClient := internal.NewHttpClient(client, logger, string(region), apiKey, opts...)
+	c := &PlatformClient{
+		League:    league.NewPlatformClient(baseClient),
+		Spectator: spectator.NewPlatformClient(baseClient),
+		Status:    status.NewPlatformClient(baseClient),
+		Summoner:  summoner.NewPlatformClient(baseClient),
+	}
+	return c
+}
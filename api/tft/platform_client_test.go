@@ -0,0 +1,20 @@
+package tft
+
+import (
+	"leago/regions"
+	"log/slog"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPlatformClient(t *testing.T) {
+	client := NewPlatformClient(http.DefaultClient, slog.Default(), regions.PlatformBR1, "apiKey")
+	require.NotNil(t, client)
+
+	require.NotNil(t, client.League)
+	require.NotNil(t, client.Spectator)
+	require.NotNil(t, client.Status)
+	require.NotNil(t, client.Summoner)
+}
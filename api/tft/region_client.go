@@ -0,0 +1,28 @@
+package tft
+
+import (
+	"leago/api/tft/match"
+	"leago/internal"
+	"leago/regions"
+	"log/slog"
+	"slices"
+)
+
+type RegionClient struct {
+	Match *match.RegionClient
+}
+
+func NewRegionClient(
+	client internal.Doer,
+	logger *slog.Logger,
+	region regions.Region,
+	apiKey string,
+	opts ...internal.ClientOption,
+) *RegionClient {
+	opts = append(slices.Clip(opts), internal.WithProduct(regions.ProductTFT))
+	baseClient := internal.NewHttpClient(client, logger, string(region), apiKey, opts...)
+	c := &RegionClient{
+		Match: match.NewRegionClient(baseClient),
+	}
+	return c
+}
@@ -0,0 +1,17 @@
+package tft
+
+import (
+	"leago/regions"
+	"log/slog"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRegionClient(t *testing.T) {
+	client := NewRegionClient(http.DefaultClient, slog.Default(), regions.RegionAmericas, "apiKey")
+	require.NotNil(t, client)
+
+	require.NotNil(t, client.Match)
+}
@@ -0,0 +1,11 @@
+package spectator
+
+import "leago/api/lol/spectator"
+
+type (
+	// Game is shared with Spectator-V5.
+	Game               = spectator.Game
+	CurrentParticipant = spectator.CurrentParticipant
+	FeaturedGames      = spectator.FeaturedGames
+	FeaturedGame       = spectator.FeaturedGame
+)
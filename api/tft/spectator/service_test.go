@@ -0,0 +1,96 @@
+package spectator
+
+import (
+	"bytes"
+	"context"
+	"leago/internal"
+	"leago/internal/mock"
+	"leago/regions"
+	"log/slog"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetActiveGameByPUUID(t *testing.T) {
+	tests := []struct {
+		name           string
+		statusCode     int
+		httpErr        error
+		responseBody   string
+		expectedResult Game
+		wantInGame     bool
+		wantErr        bool
+		wantRiotErr    bool
+	}{
+		{
+			name:         "not in game",
+			statusCode:   http.StatusNotFound,
+			responseBody: `{"status":{"status_code":404}}`,
+			wantInGame:   false,
+		},
+		{
+			name:         "riot error",
+			statusCode:   http.StatusForbidden,
+			responseBody: `{"status":{"status_code":403}}`,
+			wantErr:      true,
+			wantRiotErr:  true,
+		},
+		{
+			name:           "success",
+			statusCode:     http.StatusOK,
+			responseBody:   `{"gameId":3000000001,"gameMode":"TFT","gameQueueConfigId":1100,"participants":[{"puuid":"test-puuid","riotId":"Player#BR1"}]}`,
+			expectedResult: Game{GameID: 3000000001, GameMode: "TFT", GameQueueConfigID: 1100, Participants: []CurrentParticipant{{PUUID: "test-puuid", RiotID: "Player#BR1"}}},
+			wantInGame:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDoer := mock.NewDefaultDoer(tt.statusCode, tt.responseBody, tt.httpErr)
+			baseClient := internal.NewHttpClient(mockDoer, slog.Default(), string(regions.PlatformBR1), "apiKey")
+			pc := NewPlatformClient(baseClient)
+			resp, inGame, err := pc.GetActiveGameByPUUID(context.Background(), "test-puuid")
+
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				if tt.wantRiotErr {
+					var rErr *internal.RiotError
+					assert.ErrorAs(t, err, &rErr)
+					assert.Equal(t, tt.statusCode, rErr.StatusCode)
+				}
+				return
+			}
+
+			require.Nil(t, err)
+			assert.Equal(t, "/lol/spectator/tft/v5/active-games/by-puuid/test-puuid", mockDoer.CapturedReq.URL.Path)
+			assert.Equal(t, tt.wantInGame, inGame)
+			assert.Equal(t, tt.expectedResult, resp)
+		})
+	}
+}
+
+func TestGetActiveGameByPUUIDNotInGameLogsDebug(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelWarn}))
+	mockDoer := mock.NewDefaultDoer(http.StatusNotFound, `{"status":{"status_code":404}}`, nil)
+	pc := NewPlatformClient(internal.NewHttpClient(mockDoer, logger, string(regions.PlatformBR1), "apiKey"))
+
+	_, inGame, err := pc.GetActiveGameByPUUID(context.Background(), "test-puuid")
+	require.NoError(t, err)
+	assert.False(t, inGame)
+	assert.Empty(t, buf.String())
+}
+
+func TestGetFeaturedGames(t *testing.T) {
+	mockDoer := mock.NewDefaultDoer(http.StatusOK, `{"gameList":[{"gameId":3000000002,"gameMode":"TFT"}],"clientRefreshInterval":300}`, nil)
+	baseClient := internal.NewHttpClient(mockDoer, slog.Default(), string(regions.PlatformBR1), "apiKey")
+
+	resp, err := NewPlatformClient(baseClient).GetFeaturedGames(context.Background())
+	require.Nil(t, err)
+
+	assert.Equal(t, "/lol/spectator/tft/v5/featured-games", mockDoer.CapturedReq.URL.Path)
+	assert.Equal(t, FeaturedGames{GameList: []FeaturedGame{{GameID: 3000000002, GameMode: "TFT"}}, ClientRefreshInterval: 300}, resp)
+}
@@ -0,0 +1,11 @@
+package status
+
+import "leago/api/lol/status"
+
+type (
+	// PlatformData is the TFT-Status-V1 response, same schema as the LoL one.
+	PlatformData = status.PlatformData
+	Status       = status.Status
+	Content      = status.Content
+	Update       = status.Update
+)
@@ -0,0 +1,6 @@
+package summoner
+
+import "leago/api/lol/summoner"
+
+// Summoner is shared with Summoner-V4, the revisionDate is decoded as time.
+type Summoner = summoner.Summoner
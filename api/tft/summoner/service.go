@@ -0,0 +1,85 @@
+package summoner
+
+import (
+	"context"
+	"fmt"
+	"leago/internal"
+	"leago/options"
+	"net/url"
+)
+
+const (
+	MethodGetByPUUID       = "TftSummoner.GetByPUUID"
+	MethodGetByAccountID   = "TftSummoner.GetByAccountID"
+	MethodGetByAccessToken = "TftSummoner.GetByAccessToken"
+)
+
+// GetByPUUID returns the summoner got by the player puuid.
+func (pc *PlatformClient) GetByPUUID(
+	ctx context.Context,
+	puuid string,
+	opts ...options.PublicOption,
+) (Summoner, error) {
+	endpoint := fmt.Sprintf(
+		"/tft/summoner/v1/summoners/by-puuid/%s",
+		url.PathEscape(puuid),
+	)
+
+	defaultOpts := []internal.RequestOption{
+		internal.WithApiMethod(MethodGetByPUUID),
+	}
+
+	uri := pc.client.GetURL(endpoint)
+	return internal.AuthRequest[Summoner](
+		ctx,
+		pc.client,
+		uri,
+		options.MergeOptions(defaultOpts, opts)...,
+	)
+}
+
+// GetByAccountID returns the summoner got by the encrypted account ID.
+func (pc *PlatformClient) GetByAccountID(
+	ctx context.Context,
+	accountID string,
+	opts ...options.PublicOption,
+) (Summoner, error) {
+	endpoint := fmt.Sprintf(
+		"/tft/summoner/v1/summoners/by-account/%s",
+		url.PathEscape(accountID),
+	)
+
+	defaultOpts := []internal.RequestOption{
+		internal.WithApiMethod(MethodGetByAccountID),
+	}
+
+	uri := pc.client.GetURL(endpoint)
+	return internal.AuthRequest[Summoner](
+		ctx,
+		pc.client,
+		uri,
+		options.MergeOptions(defaultOpts, opts)...,
+	)
+}
+
+// GetByAccessToken returns the summoner of the player that authorized the RSO access token.
+func (pc *PlatformClient) GetByAccessToken(
+	ctx context.Context,
+	accessToken string,
+	opts ...options.PublicOption,
+) (Summoner, error) {
+	endpoint := "/tft/summoner/v1/summoners/me"
+
+	defaultOpts := []internal.RequestOption{
+		internal.WithApiMethod(MethodGetByAccessToken),
+		internal.WithBearerToken(accessToken),
+	}
+
+	uri := pc.client.GetURL(endpoint)
+	return internal.AuthRequest[Summoner](
+		ctx,
+		pc.client,
+		uri,
+		options.MergeOptions(defaultOpts, opts)...,
+	)
+}
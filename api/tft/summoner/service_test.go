@@ -0,0 +1,80 @@
+package summoner
+
+import (
+	"context"
+	"leago/internal"
+	"leago/internal/mock"
+	"leago/regions"
+	"log/slog"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSummonerEndpoints(t *testing.T) {
+	tests := []struct {
+		name       string
+		call       func(pc *PlatformClient) (Summoner, error)
+		wantPath   string
+		wantBearer string
+	}{
+		{
+			name: "by puuid",
+			call: func(pc *PlatformClient) (Summoner, error) {
+				return pc.GetByPUUID(context.Background(), "test-puuid")
+			},
+			wantPath: "/tft/summoner/v1/summoners/by-puuid/test-puuid",
+		},
+		{
+			name: "by account id",
+			call: func(pc *PlatformClient) (Summoner, error) {
+				return pc.GetByAccountID(context.Background(), "test-account")
+			},
+			wantPath: "/tft/summoner/v1/summoners/by-account/test-account",
+		},
+		{
+			name: "by access token",
+			call: func(pc *PlatformClient) (Summoner, error) {
+				return pc.GetByAccessToken(context.Background(), "access-token")
+			},
+			wantPath:   "/tft/summoner/v1/summoners/me",
+			wantBearer: "Bearer access-token",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDoer := mock.NewDefaultDoer(
+				http.StatusOK,
+				`{"puuid":"test-puuid","profileIconId":1,"revisionDate":1700000000000,"summonerLevel":100}`,
+				nil,
+			)
+			baseClient := internal.NewHttpClient(mockDoer, slog.Default(), string(regions.PlatformBR1), "apiKey")
+			resp, err := tt.call(NewPlatformClient(baseClient))
+
+			require.Nil(t, err)
+			assert.Equal(t, tt.wantPath, mockDoer.CapturedReq.URL.Path)
+			assert.Equal(t, tt.wantBearer, mockDoer.CapturedReq.Header.Get("Authorization"))
+			assert.Equal(t, Summoner{
+				PUUID:         "test-puuid",
+				ProfileIconID: 1,
+				RevisionDate:  time.UnixMilli(1700000000000),
+				SummonerLevel: 100,
+			}, resp)
+		})
+	}
+}
+
+func TestGetByPUUIDRiotError(t *testing.T) {
+	mockDoer := mock.NewDefaultDoer(http.StatusNotFound, `{"status":{"status_code":404}}`, nil)
+	baseClient := internal.NewHttpClient(mockDoer, slog.Default(), string(regions.PlatformBR1), "apiKey")
+
+	_, err := NewPlatformClient(baseClient).GetByPUUID(context.Background(), "test-puuid")
+
+	var rErr *internal.RiotError
+	require.ErrorAs(t, err, &rErr)
+	assert.Equal(t, http.StatusNotFound, rErr.StatusCode)
+}
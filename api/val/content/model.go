@@ -0,0 +1,92 @@
+package content
+
+type (
+	Content struct {
+		Version      string `json:"version"`
+		Characters   []Item `json:"characters"`
+		Maps         []Item `json:"maps"`
+		Chromas      []Item `json:"chromas"`
+		Skins        []Item `json:"skins"`
+		SkinLevels   []Item `json:"skinLevels"`
+		Equips       []Item `json:"equips"`
+		GameModes    []Item `json:"gameModes"`
+		Sprays       []Item `json:"sprays"`
+		SprayLevels  []Item `json:"sprayLevels"`
+		Charms       []Item `json:"charms"`
+		CharmLevels  []Item `json:"charmLevels"`
+		PlayerCards  []Item `json:"playerCards"`
+		PlayerTitles []Item `json:"playerTitles"`
+		Acts         []Act  `json:"acts"`
+		Ceremonies   []Item `json:"ceremonies"`
+	}
+
+	// Item is a single content entry, like an agent or a map.
+	// LocalizedNames is only sent when the content is requested without a locale.
+	Item struct {
+		Name           string            `json:"name"`
+		LocalizedNames map[string]string `json:"localizedNames,omitempty"`
+		ID             string            `json:"id"`
+		AssetName      string            `json:"assetName"`
+		AssetPath      string            `json:"assetPath"`
+	}
+
+	Act struct {
+		Name           string            `json:"name"`
+		LocalizedNames map[string]string `json:"localizedNames,omitempty"`
+		ID             string            `json:"id"`
+		ParentID       string            `json:"parentId"`
+		Type           string            `json:"type"`
+		IsActive       bool              `json:"isActive"`
+	}
+
+	Locale string
+)
+
+const (
+	LocaleArAE Locale = "ar-AE"
+	LocaleDeDE Locale = "de-DE"
+	LocaleEnGB Locale = "en-GB"
+	LocaleEnUS Locale = "en-US"
+	LocaleEsES Locale = "es-ES"
+	LocaleEsMX Locale = "es-MX"
+	LocaleFrFR Locale = "fr-FR"
+	LocaleIDID Locale = "id-ID"
+	LocaleItIT Locale = "it-IT"
+	LocaleJaJP Locale = "ja-JP"
+	LocaleKoKR Locale = "ko-KR"
+	LocalePlPL Locale = "pl-PL"
+	LocalePtBR Locale = "pt-BR"
+	LocaleRuRU Locale = "ru-RU"
+	LocaleThTH Locale = "th-TH"
+	LocaleTrTR Locale = "tr-TR"
+	LocaleViVN Locale = "vi-VN"
+	LocaleZhCN Locale = "zh-CN"
+	LocaleZhTW Locale = "zh-TW"
+)
+
+// LocalizedName returns the item name on the locale, falling back to the name sent by Riot.
+func (i Item) LocalizedName(locale Locale) string {
+	if name, ok := i.LocalizedNames[string(locale)]; ok && name != "" {
+		return name
+	}
+	return i.Name
+}
+
+// LocalizedName returns the act name on the locale, falling back to the name sent by Riot.
+func (a Act) LocalizedName(locale Locale) string {
+	if name, ok := a.LocalizedNames[string(locale)]; ok && name != "" {
+		return name
+	}
+	return a.Name
+}
+
+// ActiveActs returns the acts currently active, usually the act and its episode.
+func (c Content) ActiveActs() []Act {
+	var out []Act
+	for _, act := range c.Acts {
+		if act.IsActive {
+			out = append(out, act)
+		}
+	}
+	return out
+}
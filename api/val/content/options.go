@@ -0,0 +1,19 @@
+package content
+
+import "leago/internal"
+
+type GetContentOption internal.RequestOption
+
+// WithLocale returns the names on the locale only, without every localized name.
+func WithLocale(locale Locale) GetContentOption {
+	return GetContentOption(internal.WithParam("locale", string(locale)))
+}
+
+// getContentOptionsToRequestOptions converts the array of options into internal request options.
+func getContentOptionsToRequestOptions(opts []GetContentOption) []internal.RequestOption {
+	out := make([]internal.RequestOption, len(opts))
+	for i, o := range opts {
+		out[i] = internal.RequestOption(o)
+	}
+	return out
+}
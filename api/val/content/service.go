@@ -0,0 +1,33 @@
+package content
+
+import (
+	"context"
+	"leago/internal"
+	"leago/options"
+)
+
+const (
+	MethodGetContent = "ValContent.GetContent"
+)
+
+// GetContent returns the content of the current patch, like agents, maps, skins and acts.
+func (sc *ShardClient) GetContent(
+	ctx context.Context,
+	endpointOpts []GetContentOption,
+	opts ...options.PublicOption,
+) (Content, error) {
+	endpoint := "/val/content/v1/contents"
+
+	defaultOpts := append(
+		[]internal.RequestOption{internal.WithApiMethod(MethodGetContent)},
+		getContentOptionsToRequestOptions(endpointOpts)...,
+	)
+
+	uri := sc.client.GetURL(endpoint)
+	return internal.AuthRequest[Content](
+		ctx,
+		sc.client,
+		uri,
+		options.MergeOptions(defaultOpts, opts)...,
+	)
+}
@@ -0,0 +1,107 @@
+package content
+
+import (
+	"context"
+	"leago/internal"
+	"leago/internal/mock"
+	"leago/regions"
+	"log/slog"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetContent(t *testing.T) {
+	tests := []struct {
+		name           string
+		statusCode     int
+		httpErr        error
+		responseBody   string
+		endpointOpts   []GetContentOption
+		expectedQuery  string
+		expectedResult Content
+		wantErr        bool
+		wantRiotErr    bool
+	}{
+		{
+			name:         "riot error",
+			statusCode:   http.StatusForbidden,
+			responseBody: `{"status":{"status_code":403}}`,
+			wantErr:      true,
+			wantRiotErr:  true,
+		},
+		{
+			name:         "unmatched json",
+			statusCode:   http.StatusOK,
+			responseBody: `{"version":1}`,
+			wantErr:      true,
+			wantRiotErr:  false,
+		},
+		{
+			name:         "success",
+			statusCode:   http.StatusOK,
+			responseBody: `{"version":"release-08.00","characters":[{"name":"Jett","localizedNames":{"pt-BR":"Jett"},"id":"add6443a","assetName":"Wushu_PrimaryAsset"}],"acts":[{"name":"ACT I","id":"act1","parentId":"ep8","type":"act","isActive":true}]}`,
+			expectedResult: Content{
+				Version:    "release-08.00",
+				Characters: []Item{{Name: "Jett", LocalizedNames: map[string]string{"pt-BR": "Jett"}, ID: "add6443a", AssetName: "Wushu_PrimaryAsset"}},
+				Acts:       []Act{{Name: "ACT I", ID: "act1", ParentID: "ep8", Type: "act", IsActive: true}},
+			},
+			wantErr: false,
+		},
+		{
+			name:           "success with locale",
+			statusCode:     http.StatusOK,
+			responseBody:   `{"version":"release-08.00"}`,
+			endpointOpts:   []GetContentOption{WithLocale(LocalePtBR)},
+			expectedQuery:  "locale=pt-BR",
+			expectedResult: Content{Version: "release-08.00"},
+			wantErr:        false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDoer := mock.NewDefaultDoer(tt.statusCode, tt.responseBody, tt.httpErr)
+			baseClient := internal.NewHttpClient(mockDoer, slog.Default(), string(regions.ShardNA), "apiKey")
+			sc := NewShardClient(baseClient)
+			resp, err := sc.GetContent(context.Background(), tt.endpointOpts)
+
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				if tt.wantRiotErr {
+					var rErr *internal.RiotError
+					assert.ErrorAs(t, err, &rErr)
+					assert.Equal(t, tt.statusCode, rErr.StatusCode)
+				}
+				return
+			}
+
+			require.Nil(t, err)
+			assert.Equal(t, "na.api.riotgames.com", mockDoer.CapturedReq.URL.Host)
+			assert.Equal(t, "/val/content/v1/contents", mockDoer.CapturedReq.URL.Path)
+			assert.Equal(t, tt.expectedQuery, mockDoer.CapturedReq.URL.RawQuery)
+			assert.Equal(t, tt.expectedResult, resp)
+		})
+	}
+}
+
+func TestLocalizedName(t *testing.T) {
+	item := Item{Name: "Jett", LocalizedNames: map[string]string{"ko-KR": "제트", "pt-BR": ""}}
+
+	assert.Equal(t, "제트", item.LocalizedName(LocaleKoKR))
+	assert.Equal(t, "Jett", item.LocalizedName(LocalePtBR))
+	assert.Equal(t, "Jett", item.LocalizedName(LocaleJaJP))
+}
+
+func TestActiveActs(t *testing.T) {
+	c := Content{Acts: []Act{
+		{ID: "ep7", IsActive: false},
+		{ID: "ep8", IsActive: true},
+		{ID: "act1", IsActive: true},
+	}}
+
+	assert.Equal(t, []Act{{ID: "ep8", IsActive: true}, {ID: "act1", IsActive: true}}, c.ActiveActs())
+	assert.Empty(t, Content{}.ActiveActs())
+}
@@ -0,0 +1,13 @@
+package content
+
+import "leago/internal"
+
+type ShardClient struct {
+	client *internal.Client
+}
+
+func NewShardClient(base *internal.Client) *ShardClient {
+	return &ShardClient{
+		base,
+	}
+}
@@ -0,0 +1,179 @@
+package match
+
+type (
+	Match struct {
+		MatchInfo    MatchInfo     `json:"matchInfo"`
+		Players      []Player      `json:"players"`
+		Coaches      []Coach       `json:"coaches"`
+		Teams        []Team        `json:"teams"`
+		RoundResults []RoundResult `json:"roundResults"`
+	}
+
+	MatchInfo struct {
+		MatchID            string `json:"matchId"`
+		MapID              string `json:"mapId"`
+		GameLengthMillis   int64  `json:"gameLengthMillis"`
+		GameStartMillis    int64  `json:"gameStartMillis"`
+		ProvisioningFlowID string `json:"provisioningFlowId"`
+		IsCompleted        bool   `json:"isCompleted"`
+		CustomGameName     string `json:"customGameName"`
+		QueueID            Queue  `json:"queueId"`
+		GameMode           string `json:"gameMode"`
+		IsRanked           bool   `json:"isRanked"`
+		SeasonID           string `json:"seasonId"`
+	}
+
+	Player struct {
+		PUUID           string       `json:"puuid"`
+		GameName        string       `json:"gameName"`
+		TagLine         string       `json:"tagLine"`
+		TeamID          string       `json:"teamId"`
+		PartyID         string       `json:"partyId"`
+		CharacterID     string       `json:"characterId"`
+		Stats           *PlayerStats `json:"stats,omitempty"`
+		CompetitiveTier int          `json:"competitiveTier"`
+		PlayerCard      string       `json:"playerCard"`
+		PlayerTitle     string       `json:"playerTitle"`
+	}
+
+	PlayerStats struct {
+		Score          int           `json:"score"`
+		RoundsPlayed   int           `json:"roundsPlayed"`
+		Kills          int           `json:"kills"`
+		Deaths         int           `json:"deaths"`
+		Assists        int           `json:"assists"`
+		PlaytimeMillis int64         `json:"playtimeMillis"`
+		AbilityCasts   *AbilityCasts `json:"abilityCasts,omitempty"`
+	}
+
+	AbilityCasts struct {
+		GrenadeCasts  int `json:"grenadeCasts"`
+		Ability1Casts int `json:"ability1Casts"`
+		Ability2Casts int `json:"ability2Casts"`
+		UltimateCasts int `json:"ultimateCasts"`
+	}
+
+	Coach struct {
+		PUUID  string `json:"puuid"`
+		TeamID string `json:"teamId"`
+	}
+
+	Team struct {
+		TeamID       string `json:"teamId"`
+		Won          bool   `json:"won"`
+		RoundsPlayed int    `json:"roundsPlayed"`
+		RoundsWon    int    `json:"roundsWon"`
+		// NumPoints is the number of kills on deathmatch.
+		NumPoints int `json:"numPoints"`
+	}
+
+	RoundResult struct {
+		RoundNum              int                `json:"roundNum"`
+		RoundResult           string             `json:"roundResult"`
+		RoundCeremony         string             `json:"roundCeremony"`
+		WinningTeam           string             `json:"winningTeam"`
+		BombPlanter           string             `json:"bombPlanter"`
+		BombDefuser           string             `json:"bombDefuser"`
+		PlantRoundTime        int64              `json:"plantRoundTime"`
+		PlantPlayerLocations  []PlayerLocation   `json:"plantPlayerLocations"`
+		PlantLocation         Location           `json:"plantLocation"`
+		PlantSite             string             `json:"plantSite"`
+		DefuseRoundTime       int64              `json:"defuseRoundTime"`
+		DefusePlayerLocations []PlayerLocation   `json:"defusePlayerLocations"`
+		DefuseLocation        Location           `json:"defuseLocation"`
+		PlayerStats           []PlayerRoundStats `json:"playerStats"`
+		RoundResultCode       string             `json:"roundResultCode"`
+	}
+
+	PlayerLocation struct {
+		PUUID       string   `json:"puuid"`
+		ViewRadians float64  `json:"viewRadians"`
+		Location    Location `json:"location"`
+	}
+
+	Location struct {
+		X int `json:"x"`
+		Y int `json:"y"`
+	}
+
+	PlayerRoundStats struct {
+		PUUID   string   `json:"puuid"`
+		Kills   []Kill   `json:"kills"`
+		Damage  []Damage `json:"damage"`
+		Score   int      `json:"score"`
+		Economy Economy  `json:"economy"`
+		Ability Ability  `json:"ability"`
+	}
+
+	Kill struct {
+		TimeSinceGameStartMillis  int64            `json:"timeSinceGameStartMillis"`
+		TimeSinceRoundStartMillis int64            `json:"timeSinceRoundStartMillis"`
+		Killer                    string           `json:"killer"`
+		Victim                    string           `json:"victim"`
+		VictimLocation            Location         `json:"victimLocation"`
+		Assistants                []string         `json:"assistants"`
+		PlayerLocations           []PlayerLocation `json:"playerLocations"`
+		FinishingDamage           FinishingDamage  `json:"finishingDamage"`
+	}
+
+	FinishingDamage struct {
+		DamageType          string `json:"damageType"`
+		DamageItem          string `json:"damageItem"`
+		IsSecondaryFireMode bool   `json:"isSecondaryFireMode"`
+	}
+
+	Damage struct {
+		Receiver  string `json:"receiver"`
+		Damage    int    `json:"damage"`
+		Legshots  int    `json:"legshots"`
+		Bodyshots int    `json:"bodyshots"`
+		Headshots int    `json:"headshots"`
+	}
+
+	Economy struct {
+		LoadoutValue int    `json:"loadoutValue"`
+		Weapon       string `json:"weapon"`
+		Armor        string `json:"armor"`
+		Remaining    int    `json:"remaining"`
+		Spent        int    `json:"spent"`
+	}
+
+	Ability struct {
+		GrenadeEffects  string `json:"grenadeEffects"`
+		Ability1Effects string `json:"ability1Effects"`
+		Ability2Effects string `json:"ability2Effects"`
+		UltimateEffects string `json:"ultimateEffects"`
+	}
+
+	Matchlist struct {
+		PUUID   string           `json:"puuid"`
+		History []MatchlistEntry `json:"history"`
+	}
+
+	MatchlistEntry struct {
+		MatchID             string `json:"matchId"`
+		GameStartTimeMillis int64  `json:"gameStartTimeMillis"`
+		QueueID             Queue  `json:"queueId"`
+	}
+
+	RecentMatches struct {
+		CurrentTime int64 `json:"currentTime"`
+		// MatchIDs are the matches that completed in the last 10 minutes.
+		MatchIDs []string `json:"matchIds"`
+	}
+
+	Queue string
+)
+
+const (
+	QueueCompetitive    Queue = "competitive"
+	QueueUnrated        Queue = "unrated"
+	QueueSpikeRush      Queue = "spikerush"
+	QueueTournamentMode Queue = "tournamentmode"
+	QueueDeathmatch     Queue = "deathmatch"
+	QueueOneForAll      Queue = "onefa"
+	QueueEscalation     Queue = "ggteam"
+	QueueTeamDeathmatch Queue = "hurm"
+	QueueSwiftplay      Queue = "swiftplay"
+	QueuePremier        Queue = "premier"
+)
@@ -0,0 +1,87 @@
+package match
+
+import (
+	"context"
+	"fmt"
+	"leago/internal"
+	"leago/options"
+	"net/url"
+)
+
+const (
+	MethodGetMatch                = "ValMatch.GetMatch"
+	MethodGetMatchlistByPUUID     = "ValMatch.GetMatchlistByPUUID"
+	MethodGetRecentMatchesByQueue = "ValMatch.GetRecentMatchesByQueue"
+)
+
+// GetMatch returns the match details got by the matchID.
+func (sc *ShardClient) GetMatch(
+	ctx context.Context,
+	matchID string,
+	opts ...options.PublicOption,
+) (Match, error) {
+	endpoint := fmt.Sprintf(
+		"/val/match/v1/matches/%s",
+		url.PathEscape(matchID),
+	)
+
+	defaultOpts := []internal.RequestOption{
+		internal.WithApiMethod(MethodGetMatch),
+	}
+
+	uri := sc.client.GetURL(endpoint)
+	return internal.AuthRequest[Match](
+		ctx,
+		sc.client,
+		uri,
+		options.MergeOptions(defaultOpts, opts)...,
+	)
+}
+
+// GetMatchlistByPUUID returns the match history of the player.
+func (sc *ShardClient) GetMatchlistByPUUID(
+	ctx context.Context,
+	puuid string,
+	opts ...options.PublicOption,
+) (Matchlist, error) {
+	endpoint := fmt.Sprintf(
+		"/val/match/v1/matchlists/by-puuid/%s",
+		url.PathEscape(puuid),
+	)
+
+	defaultOpts := []internal.RequestOption{
+		internal.WithApiMethod(MethodGetMatchlistByPUUID),
+	}
+
+	uri := sc.client.GetURL(endpoint)
+	return internal.AuthRequest[Matchlist](
+		ctx,
+		sc.client,
+		uri,
+		options.MergeOptions(defaultOpts, opts)...,
+	)
+}
+
+// GetRecentMatchesByQueue returns the IDs of the matches of the queue completed in the last 10 minutes.
+func (sc *ShardClient) GetRecentMatchesByQueue(
+	ctx context.Context,
+	queue Queue,
+	opts ...options.PublicOption,
+) (RecentMatches, error) {
+	endpoint := fmt.Sprintf(
+		"/val/match/v1/recent-matches/by-queue/%s",
+		queue,
+	)
+
+	defaultOpts := []internal.RequestOption{
+		internal.WithApiMethod(MethodGetRecentMatchesByQueue),
+	}
+
+	uri := sc.client.GetURL(endpoint)
+	return internal.AuthRequest[RecentMatches](
+		ctx,
+		sc.client,
+		uri,
+		options.MergeOptions(defaultOpts, opts)...,
+	)
+}
@@ -0,0 +1,201 @@
+package match
+
+import (
+	"context"
+	"leago/internal"
+	"leago/internal/mock"
+	"leago/regions"
+	"log/slog"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMatch(t *testing.T) {
+	tests := []struct {
+		name           string
+		statusCode     int
+		httpErr        error
+		responseBody   string
+		expectedResult Match
+		wantErr        bool
+		wantRiotErr    bool
+	}{
+		{
+			name:         "riot error",
+			statusCode:   http.StatusNotFound,
+			responseBody: `{"status":{"status_code":404}}`,
+			wantErr:      true,
+			wantRiotErr:  true,
+		},
+		{
+			name:         "unmatched json",
+			statusCode:   http.StatusOK,
+			responseBody: `{"matchInfo":[]}`,
+			wantErr:      true,
+			wantRiotErr:  false,
+		},
+		{
+			name:         "success",
+			statusCode:   http.StatusOK,
+			responseBody: `{"matchInfo":{"matchId":"id","mapId":"/Game/Maps/Ascent/Ascent","queueId":"competitive","isRanked":true},"players":[{"puuid":"puuid","teamId":"Red","stats":{"kills":20,"abilityCasts":{"ultimateCasts":2}}}],"teams":[{"teamId":"Red","won":true,"roundsWon":13}],"roundResults":[{"roundNum":0,"winningTeam":"Red","playerStats":[{"puuid":"puuid","kills":[{"killer":"puuid","victim":"other","victimLocation":{"x":1,"y":2}}],"economy":{"weapon":"vandal","spent":2900}}]}]}`,
+			expectedResult: Match{
+				MatchInfo: MatchInfo{MatchID: "id", MapID: "/Game/Maps/Ascent/Ascent", QueueID: QueueCompetitive, IsRanked: true},
+				Players:   []Player{{PUUID: "puuid", TeamID: "Red", Stats: &PlayerStats{Kills: 20, AbilityCasts: &AbilityCasts{UltimateCasts: 2}}}},
+				Teams:     []Team{{TeamID: "Red", Won: true, RoundsWon: 13}},
+				RoundResults: []RoundResult{{
+					WinningTeam: "Red",
+					PlayerStats: []PlayerRoundStats{{
+						PUUID:   "puuid",
+						Kills:   []Kill{{Killer: "puuid", Victim: "other", VictimLocation: Location{X: 1, Y: 2}}},
+						Economy: Economy{Weapon: "vandal", Spent: 2900},
+					}},
+				}},
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDoer := mock.NewDefaultDoer(tt.statusCode, tt.responseBody, tt.httpErr)
+			baseClient := internal.NewHttpClient(mockDoer, slog.Default(), string(regions.ShardNA), "apiKey")
+			sc := NewShardClient(baseClient)
+			resp, err := sc.GetMatch(context.Background(), "id")
+
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				if tt.wantRiotErr {
+					var rErr *internal.RiotError
+					assert.ErrorAs(t, err, &rErr)
+					assert.Equal(t, tt.statusCode, rErr.StatusCode)
+				}
+				return
+			}
+
+			require.Nil(t, err)
+			assert.Equal(t, "/val/match/v1/matches/id", mockDoer.CapturedReq.URL.Path)
+			assert.Equal(t, tt.expectedResult, resp)
+		})
+	}
+}
+
+func TestGetMatchlistByPUUID(t *testing.T) {
+	tests := []struct {
+		name           string
+		statusCode     int
+		httpErr        error
+		responseBody   string
+		expectedResult Matchlist
+		wantErr        bool
+		wantRiotErr    bool
+	}{
+		{
+			name:         "riot error",
+			statusCode:   http.StatusBadRequest,
+			responseBody: `{"status":{"status_code":400}}`,
+			wantErr:      true,
+			wantRiotErr:  true,
+		},
+		{
+			name:         "unmatched json",
+			statusCode:   http.StatusOK,
+			responseBody: `{"history":{}}`,
+			wantErr:      true,
+			wantRiotErr:  false,
+		},
+		{
+			name:         "success",
+			statusCode:   http.StatusOK,
+			responseBody: `{"puuid":"puuid","history":[{"matchId":"id","gameStartTimeMillis":1700000000000,"queueId":"unrated"}]}`,
+			expectedResult: Matchlist{
+				PUUID:   "puuid",
+				History: []MatchlistEntry{{MatchID: "id", GameStartTimeMillis: 1700000000000, QueueID: QueueUnrated}},
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDoer := mock.NewDefaultDoer(tt.statusCode, tt.responseBody, tt.httpErr)
+			baseClient := internal.NewHttpClient(mockDoer, slog.Default(), string(regions.ShardEU), "apiKey")
+			sc := NewShardClient(baseClient)
+			resp, err := sc.GetMatchlistByPUUID(context.Background(), "puuid")
+
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				if tt.wantRiotErr {
+					var rErr *internal.RiotError
+					assert.ErrorAs(t, err, &rErr)
+					assert.Equal(t, tt.statusCode, rErr.StatusCode)
+				}
+				return
+			}
+
+			require.Nil(t, err)
+			assert.Equal(t, "eu.api.riotgames.com", mockDoer.CapturedReq.URL.Host)
+			assert.Equal(t, "/val/match/v1/matchlists/by-puuid/puuid", mockDoer.CapturedReq.URL.Path)
+			assert.Equal(t, tt.expectedResult, resp)
+		})
+	}
+}
+
+func TestGetRecentMatchesByQueue(t *testing.T) {
+	tests := []struct {
+		name           string
+		statusCode     int
+		httpErr        error
+		responseBody   string
+		expectedResult RecentMatches
+		wantErr        bool
+		wantRiotErr    bool
+	}{
+		{
+			name:         "riot error",
+			statusCode:   http.StatusForbidden,
+			responseBody: `{"status":{"status_code":403}}`,
+			wantErr:      true,
+			wantRiotErr:  true,
+		},
+		{
+			name:         "unmatched json",
+			statusCode:   http.StatusOK,
+			responseBody: `{"matchIds":"id"}`,
+			wantErr:      true,
+			wantRiotErr:  false,
+		},
+		{
+			name:           "success",
+			statusCode:     http.StatusOK,
+			responseBody:   `{"currentTime":1700000000000,"matchIds":["id1","id2"]}`,
+			expectedResult: RecentMatches{CurrentTime: 1700000000000, MatchIDs: []string{"id1", "id2"}},
+			wantErr:        false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDoer := mock.NewDefaultDoer(tt.statusCode, tt.responseBody, tt.httpErr)
+			baseClient := internal.NewHttpClient(mockDoer, slog.Default(), string(regions.ShardNA), "apiKey")
+			sc := NewShardClient(baseClient)
+			resp, err := sc.GetRecentMatchesByQueue(context.Background(), QueueSwiftplay)
+
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				if tt.wantRiotErr {
+					var rErr *internal.RiotError
+					assert.ErrorAs(t, err, &rErr)
+					assert.Equal(t, tt.statusCode, rErr.StatusCode)
+				}
+				return
+			}
+
+			require.Nil(t, err)
+			assert.Equal(t, "/val/match/v1/recent-matches/by-queue/swiftplay", mockDoer.CapturedReq.URL.Path)
+			assert.Equal(t, tt.expectedResult, resp)
+		})
+	}
+}
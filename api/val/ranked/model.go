@@ -0,0 +1,34 @@
+package ranked
+
+type (
+	Leaderboard struct {
+		Shard                 string       `json:"shard"`
+		ActID                 string       `json:"actId"`
+		TotalPlayers          int64        `json:"totalPlayers"`
+		Players               []Player     `json:"players"`
+		TierDetails           []TierDetail `json:"tierDetails"`
+		ImmortalStartingPage  int          `json:"immortalStartingPage"`
+		ImmortalStartingIndex int          `json:"immortalStartingIndex"`
+		TopTierRRThreshold    int          `json:"topTierRRThreshold"`
+		StartIndex            int          `json:"startIndex"`
+		Query                 string       `json:"query"`
+	}
+
+	// Player is a leaderboard entry, the puuid and names are empty for anonymous players.
+	Player struct {
+		PUUID           string `json:"puuid"`
+		GameName        string `json:"gameName"`
+		TagLine         string `json:"tagLine"`
+		LeaderboardRank int64  `json:"leaderboardRank"`
+		RankedRating    int    `json:"rankedRating"`
+		NumberOfWins    int    `json:"numberOfWins"`
+		CompetitiveTier int    `json:"competitiveTier"`
+	}
+
+	TierDetail struct {
+		RankedRatingThreshold int `json:"rankedRatingThreshold"`
+		StartingPage          int `json:"startingPage"`
+		StartingIndex         int `json:"startingIndex"`
+		Tier                  int `json:"tier"`
+	}
+)
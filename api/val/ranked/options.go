@@ -0,0 +1,27 @@
+package ranked
+
+import (
+	"leago/internal"
+	"strconv"
+)
+
+type GetLeaderboardOption internal.RequestOption
+
+// WithSize sets the number of players returned, between 1 and 200 (Default 200).
+func WithSize(size int) GetLeaderboardOption {
+	return GetLeaderboardOption(internal.WithParam("size", strconv.Itoa(size)))
+}
+
+// WithStartIndex sets the index of the first player returned (Default 0).
+func WithStartIndex(startIndex int) GetLeaderboardOption {
+	return GetLeaderboardOption(internal.WithParam("startIndex", strconv.Itoa(startIndex)))
+}
+
+// getLeaderboardOptionsToRequestOptions converts the array of options into internal request options.
+func getLeaderboardOptionsToRequestOptions(opts []GetLeaderboardOption) []internal.RequestOption {
+	out := make([]internal.RequestOption, len(opts))
+	for i, o := range opts {
+		out[i] = internal.RequestOption(o)
+	}
+	return out
+}
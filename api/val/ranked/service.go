@@ -0,0 +1,39 @@
+package ranked
+
+import (
+	"context"
+	"fmt"
+	"leago/internal"
+	"leago/options"
+	"net/url"
+)
+
+const (
+	MethodGetLeaderboardByAct = "ValRanked.GetLeaderboardByAct"
+)
+
+// GetLeaderboardByAct returns a page of the competitive leaderboard of the act, paged by size and start index.
+func (sc *ShardClient) GetLeaderboardByAct(
+	ctx context.Context,
+	actID string,
+	endpointOpts []GetLeaderboardOption,
+	opts ...options.PublicOption,
+) (Leaderboard, error) {
+	endpoint := fmt.Sprintf(
+		"/val/ranked/v1/leaderboards/by-act/%s",
+		url.PathEscape(actID),
+	)
+
+	defaultOpts := append(
+		[]internal.RequestOption{internal.WithApiMethod(MethodGetLeaderboardByAct)},
+		getLeaderboardOptionsToRequestOptions(endpointOpts)...,
+	)
+
+	uri := sc.client.GetURL(endpoint)
+	return internal.AuthRequest[Leaderboard](
+		ctx,
+		sc.client,
+		uri,
+		options.MergeOptions(defaultOpts, opts)...,
+	)
+}
@@ -0,0 +1,93 @@
+package ranked
+
+import (
+	"context"
+	"leago/internal"
+	"leago/internal/mock"
+	"leago/regions"
+	"log/slog"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetLeaderboardByAct(t *testing.T) {
+	tests := []struct {
+		name           string
+		statusCode     int
+		httpErr        error
+		responseBody   string
+		endpointOpts   []GetLeaderboardOption
+		expectedQuery  string
+		expectedResult Leaderboard
+		wantErr        bool
+		wantRiotErr    bool
+	}{
+		{
+			name:         "riot error",
+			statusCode:   http.StatusNotFound,
+			responseBody: `{"status":{"status_code":404}}`,
+			wantErr:      true,
+			wantRiotErr:  true,
+		},
+		{
+			name:         "unmatched json",
+			statusCode:   http.StatusOK,
+			responseBody: `{"players":{}}`,
+			wantErr:      true,
+			wantRiotErr:  false,
+		},
+		{
+			name:         "success",
+			statusCode:   http.StatusOK,
+			responseBody: `{"shard":"na","actId":"act","totalPlayers":2,"players":[{"puuid":"puuid","gameName":"name","tagLine":"tag","leaderboardRank":1,"rankedRating":900,"numberOfWins":120,"competitiveTier":27},{"leaderboardRank":2,"rankedRating":850,"numberOfWins":100,"competitiveTier":27}],"tierDetails":[{"rankedRatingThreshold":450,"startingPage":1,"startingIndex":1,"tier":27}],"topTierRRThreshold":450}`,
+			expectedResult: Leaderboard{
+				Shard:        "na",
+				ActID:        "act",
+				TotalPlayers: 2,
+				Players: []Player{
+					{PUUID: "puuid", GameName: "name", TagLine: "tag", LeaderboardRank: 1, RankedRating: 900, NumberOfWins: 120, CompetitiveTier: 27},
+					{LeaderboardRank: 2, RankedRating: 850, NumberOfWins: 100, CompetitiveTier: 27},
+				},
+				TierDetails:        []TierDetail{{RankedRatingThreshold: 450, StartingPage: 1, StartingIndex: 1, Tier: 27}},
+				TopTierRRThreshold: 450,
+			},
+			wantErr: false,
+		},
+		{
+			name:           "success with paging",
+			statusCode:     http.StatusOK,
+			responseBody:   `{"shard":"na","actId":"act","startIndex":200}`,
+			endpointOpts:   []GetLeaderboardOption{WithSize(100), WithStartIndex(200)},
+			expectedQuery:  "size=100&startIndex=200",
+			expectedResult: Leaderboard{Shard: "na", ActID: "act", StartIndex: 200},
+			wantErr:        false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDoer := mock.NewDefaultDoer(tt.statusCode, tt.responseBody, tt.httpErr)
+			baseClient := internal.NewHttpClient(mockDoer, slog.Default(), string(regions.ShardNA), "apiKey")
+			sc := NewShardClient(baseClient)
+			resp, err := sc.GetLeaderboardByAct(context.Background(), "act", tt.endpointOpts)
+
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				if tt.wantRiotErr {
+					var rErr *internal.RiotError
+					assert.ErrorAs(t, err, &rErr)
+					assert.Equal(t, tt.statusCode, rErr.StatusCode)
+				}
+				return
+			}
+
+			require.Nil(t, err)
+			assert.Equal(t, "/val/ranked/v1/leaderboards/by-act/act", mockDoer.CapturedReq.URL.Path)
+			assert.Equal(t, tt.expectedQuery, mockDoer.CapturedReq.URL.RawQuery)
+			assert.Equal(t, tt.expectedResult, resp)
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package val
+
+import (
+	"leago/api/val/content"
+	"leago/api/val/match"
+	"leago/api/val/ranked"
+	"leago/api/val/status"
+	"leago/internal"
+	"leago/regions"
+	"log/slog"
+	"slices"
+)
+
+type ShardClient struct {
+	Content *content.ShardClient
+	Match   *match.ShardClient
+	Ranked  *ranked.ShardClient
+	Status  *status.ShardClient
+}
+
+func NewShardClient(
+	client internal.Doer,
+	logger *slog.Logger,
+	shard regions.Shard,
+	apiKey string,
+	opts ...internal.ClientOption,
+) *ShardClient {
+	opts = append(slices.Clip(opts), internal.WithProduct(regions.ProductVAL))
+	baseClient := internal.NewHttpClient(client, logger, string(shard), apiKey, opts...)
+	c := &ShardClient{
+		Content: content.NewShardClient(baseClient),
+		Match:   match.NewShardClient(baseClient),
+		Ranked:  ranked.NewShardClient(baseClient),
+		Status:  status.NewShardClient(baseClient),
+	}
+	return c
+}
@@ -0,0 +1,20 @@
+package val
+
+import (
+	"leago/regions"
+	"log/slog"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewShardClient(t *testing.T) {
+	client := NewShardClient(http.DefaultClient, slog.Default(), regions.ShardNA, "apiKey")
+	require.NotNil(t, client)
+
+	require.NotNil(t, client.Content)
+	require.NotNil(t, client.Match)
+	require.NotNil(t, client.Ranked)
+	require.NotNil(t, client.Status)
+}
@@ -0,0 +1,11 @@
+package status
+
+import "leago/api/lol/status"
+
+type (
+	// PlatformData is the status of a VALORANT shard, reported with the LoL status schema.
+	PlatformData = status.PlatformData
+	Status       = status.Status
+	Content      = status.Content
+	Update       = status.Update
+)
@@ -0,0 +1,31 @@
+package status
+
+import (
+	"context"
+	"leago/internal"
+	"leago/options"
+)
+
+const (
+	MethodGetPlatformData = "ValStatus.GetPlatformData"
+)
+
+// GetPlatformData returns the current incidents and maintenances of the shard.
+func (sc *ShardClient) GetPlatformData(
+	ctx context.Context,
+	opts ...options.PublicOption,
+) (PlatformData, error) {
+	endpoint := "/val/status/v1/platform-data"
+
+	defaultOpts := []internal.RequestOption{
+		internal.WithApiMethod(MethodGetPlatformData),
+	}
+
+	uri := sc.client.GetURL(endpoint)
+	return internal.AuthRequest[PlatformData](
+		ctx,
+		sc.client,
+		uri,
+		options.MergeOptions(defaultOpts, opts)...,
+	)
+}
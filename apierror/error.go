@@ -0,0 +1,89 @@
+// Package apierror contains the errors returned by the Riot API clients.
+// Use errors.Is with the sentinel errors to classify a failure, or errors.As with *RiotError to inspect it.
+package apierror
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type (
+	// RiotError is returned when the Riot API answers with a non-OK status.
+	RiotError struct {
+		StatusCode int
+		Status     string
+		// Body is the raw response body, Message is the message parsed from it when available.
+		Body    string
+		Message string
+		// RateLimitType is the X-Rate-Limit-Type header sent on 429 (application, method or service).
+		RateLimitType string
+		RetryAfter    time.Duration
+		Endpoint      string
+		ApiMethod     string
+	}
+
+	// DecodeError is returned when a successful response body can't be decoded into the expected type.
+	DecodeError struct {
+		ApiMethod string
+		// Snippet is the start of the response body, truncated to avoid logging huge payloads.
+		Snippet string
+		Err     error
+	}
+)
+
+var (
+	ErrNotFound           = errors.New("not found")
+	ErrUnauthorized       = errors.New("unauthorized")
+	ErrForbidden          = errors.New("forbidden")
+	ErrRateLimited        = errors.New("rate limited")
+	ErrServiceUnavailable = errors.New("service unavailable")
+)
+
+func (e *RiotError) Error() string {
+	status := e.Status
+	if status == "" {
+		status = fmt.Sprintf("%d %s", e.StatusCode, http.StatusText(e.StatusCode))
+	}
+
+	msg := "riot api error: "
+	if e.ApiMethod != "" {
+		msg += e.ApiMethod + ": "
+	}
+	msg += status
+
+	if e.Message != "" {
+		msg += fmt.Sprintf(" (message: %s)", e.Message)
+	} else if e.Body != "" {
+		msg += fmt.Sprintf(" (body: %s)", e.Body)
+	}
+
+	return msg
+}
+
+// Is matches the sentinel errors by status code, allowing errors.Is(err, apierror.ErrNotFound).
+func (e *RiotError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrServiceUnavailable:
+		return e.StatusCode == http.StatusServiceUnavailable
+	default:
+		return false
+	}
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("failed to decode %s response: %v (body: %s)", e.ApiMethod, e.Err, e.Snippet)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
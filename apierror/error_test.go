@@ -0,0 +1,77 @@
+package apierror_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"leago/apierror"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRiotErrorIs(t *testing.T) {
+	tests := []struct {
+		statusCode int
+		want       error
+	}{
+		{statusCode: http.StatusNotFound, want: apierror.ErrNotFound},
+		{statusCode: http.StatusUnauthorized, want: apierror.ErrUnauthorized},
+		{statusCode: http.StatusForbidden, want: apierror.ErrForbidden},
+		{statusCode: http.StatusTooManyRequests, want: apierror.ErrRateLimited},
+		{statusCode: http.StatusServiceUnavailable, want: apierror.ErrServiceUnavailable},
+	}
+
+	sentinels := []error{
+		apierror.ErrNotFound,
+		apierror.ErrUnauthorized,
+		apierror.ErrForbidden,
+		apierror.ErrRateLimited,
+		apierror.ErrServiceUnavailable,
+	}
+
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.statusCode), func(t *testing.T) {
+			err := fmt.Errorf("wrapped: %w", &apierror.RiotError{StatusCode: tt.statusCode})
+			for _, sentinel := range sentinels {
+				assert.Equal(t, sentinel == tt.want, errors.Is(err, sentinel), sentinel.Error())
+			}
+		})
+	}
+}
+
+func TestRiotErrorMessage(t *testing.T) {
+	withBody := &apierror.RiotError{StatusCode: 400, Status: "400 Bad Request", Body: "payload"}
+	assert.Contains(t, withBody.Error(), "body: payload")
+
+	withMessage := &apierror.RiotError{
+		StatusCode: 404,
+		Body:       `{"status":{"message":"Data not found","status_code":404}}`,
+		Message:    "Data not found",
+		ApiMethod:  "League.GetLeagueByID",
+	}
+	assert.Contains(t, withMessage.Error(), "League.GetLeagueByID")
+	assert.Contains(t, withMessage.Error(), "404 Not Found")
+	assert.Contains(t, withMessage.Error(), "message: Data not found")
+	assert.NotContains(t, withMessage.Error(), "body:")
+}
+
+func TestDecodeError(t *testing.T) {
+	var target struct{}
+	jsonErr := json.Unmarshal([]byte("invalid"), &target)
+
+	err := fmt.Errorf("wrapped: %w", &apierror.DecodeError{
+		ApiMethod: "Champion.GetRotation",
+		Snippet:   "invalid",
+		Err:       jsonErr,
+	})
+
+	var dErr *apierror.DecodeError
+	require.ErrorAs(t, err, &dErr)
+	assert.Contains(t, err.Error(), "Champion.GetRotation")
+
+	var syntaxErr *json.SyntaxError
+	assert.ErrorAs(t, err, &syntaxErr)
+}
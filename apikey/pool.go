@@ -0,0 +1,245 @@
+package apikey
+
+import (
+	"context"
+	"leago/internal"
+	"leago/regions"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+)
+
+type (
+	// Pool spreads requests across multiple keys, disabling keys rejected with 403 for a cooldown.
+	Pool struct {
+		mu       sync.Mutex
+		keys     []*poolKey
+		strategy strategy
+		next     int
+		cooldown time.Duration
+		now      func() time.Time
+	}
+
+	// PoolOption configures a Pool.
+	PoolOption func(*Pool)
+
+	poolKey struct {
+		key string
+		// products the key is registered for, empty when it serves every product.
+		products      []regions.Product
+		disabledUntil time.Time
+		// usage is the rate limit state of the key for each route.
+		usage map[string]*keyUsage
+	}
+
+	// keyUsage is the last known application limit state of a key, plus the requests sent since then.
+	keyUsage struct {
+		limits []internal.RateLimitWindow
+		counts []internal.RateLimitWindow
+		// countedAt is when the counts were observed, each count expires once its window has passed.
+		countedAt time.Time
+		pending   int
+	}
+
+	strategy int
+)
+
+const (
+	strategyRoundRobin strategy = iota
+	strategyLeastUsed
+
+	// DefaultCooldown is how long a key rejected with 403 stays disabled.
+	DefaultCooldown = 10 * time.Minute
+)
+
+var _ internal.KeyReleaser = (*Pool)(nil)
+
+// NewRoundRobin returns a pool using each key in turn.
+// The keys serve every product, use WithProductKeys for keys registered for a single product.
+func NewRoundRobin(keys []string, opts ...PoolOption) *Pool {
+	return newPool(strategyRoundRobin, keys, opts)
+}
+
+// NewLeastUsed returns a pool using the key with the lowest application rate limit usage for the route.
+// Usage is learned from the X-App-Rate-Limit-Count headers and the requests still in flight.
+func NewLeastUsed(keys []string, opts ...PoolOption) *Pool {
+	return newPool(strategyLeastUsed, keys, opts)
+}
+
+// WithCooldown sets how long a key rejected with 403 stays disabled.
+func WithCooldown(cooldown time.Duration) PoolOption {
+	return func(p *Pool) {
+		p.cooldown = cooldown
+	}
+}
+
+// WithProductKeys adds keys registered for the product, only used for requests to its APIs.
+// Keys passed to the pool constructor serve every product, including the shared APIs like Account-V1.
+func WithProductKeys(product regions.Product, keys ...string) PoolOption {
+	return func(p *Pool) {
+		for _, key := range keys {
+			if key == "" {
+				continue
+			}
+
+			if i := slices.IndexFunc(p.keys, func(k *poolKey) bool { return k.key == key }); i >= 0 {
+				p.keys[i].products = append(p.keys[i].products, product)
+				continue
+			}
+			p.keys = append(p.keys, &poolKey{key: key, products: []regions.Product{product}, usage: make(map[string]*keyUsage)})
+		}
+	}
+}
+
+func newPool(s strategy, keys []string, opts []PoolOption) *Pool {
+	p := &Pool{
+		strategy: s,
+		cooldown: DefaultCooldown,
+		now:      time.Now,
+	}
+
+	for _, key := range keys {
+		if key != "" {
+			p.keys = append(p.keys, &poolKey{key: key, usage: make(map[string]*keyUsage)})
+		}
+	}
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	return p
+}
+
+// Key returns the next enabled key serving the product of the request.
+func (p *Pool) Key(_ context.Context, info internal.RequestInfo) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if !slices.ContainsFunc(p.keys, func(k *poolKey) bool { return k.serves(info.Product) }) {
+		return "", ErrNoKey
+	}
+
+	now := p.now()
+	var picked *poolKey
+	switch p.strategy {
+	case strategyLeastUsed:
+		picked = p.leastUsed(info, now)
+	default:
+		picked = p.roundRobin(info, now)
+	}
+
+	if picked == nil {
+		return "", ErrAllKeysDown
+	}
+
+	picked.usageFor(info.Route).pending++
+	return picked.key, nil
+}
+
+// Observe disables keys rejected with 403 and updates the key usage from the rate limit headers.
+func (p *Pool) Observe(key string, info internal.RequestInfo, statusCode int, header http.Header) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, k := range p.keys {
+		if k.key != key {
+			continue
+		}
+
+		if statusCode == http.StatusForbidden {
+			k.disabledUntil = p.now().Add(p.cooldown)
+		}
+
+		usage := k.usageFor(info.Route)
+		usage.pending = max(usage.pending-1, 0)
+		if limits := internal.ParseRateLimitHeader(header.Get(internal.HeaderAppRateLimit)); len(limits) > 0 {
+			usage.limits = limits
+		}
+		if counts := internal.ParseRateLimitHeader(header.Get(internal.HeaderAppRateLimitCount)); len(counts) > 0 {
+			usage.counts, usage.countedAt = counts, p.now()
+		}
+		return
+	}
+}
+
+// Release returns a key handed out by Key that was never sent, so it no longer counts as in flight.
+func (p *Pool) Release(key string, info internal.RequestInfo) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, k := range p.keys {
+		if k.key == key {
+			usage := k.usageFor(info.Route)
+			usage.pending = max(usage.pending-1, 0)
+			return
+		}
+	}
+}
+
+func (p *Pool) roundRobin(info internal.RequestInfo, now time.Time) *poolKey {
+	for range p.keys {
+		k := p.keys[p.next%len(p.keys)]
+		p.next++
+		if k.enabled(now) && k.serves(info.Product) {
+			return k
+		}
+	}
+	return nil
+}
+
+func (p *Pool) leastUsed(info internal.RequestInfo, now time.Time) *poolKey {
+	var picked *poolKey
+	var lowest float64
+	for _, k := range p.keys {
+		if !k.enabled(now) || !k.serves(info.Product) {
+			continue
+		}
+
+		score := k.usageFor(info.Route).score(now)
+		if picked == nil || score < lowest {
+			picked, lowest = k, score
+		}
+	}
+	return picked
+}
+
+func (k *poolKey) enabled(now time.Time) bool {
+	return !now.Before(k.disabledUntil)
+}
+
+// serves reports if the key can be used for the product, shared APIs without a product accept any key.
+func (k *poolKey) serves(product regions.Product) bool {
+	return len(k.products) == 0 || product == "" || slices.Contains(k.products, product)
+}
+
+func (k *poolKey) usageFor(route string) *keyUsage {
+	u, ok := k.usage[route]
+	if !ok {
+		u = &keyUsage{}
+		k.usage[route] = u
+	}
+	return u
+}
+
+// score is the highest usage ratio among the key windows, counting the requests in flight.
+// Counts of windows that have passed since they were observed are dropped, the window was reset since then.
+// Keys without known limits are scored by the requests in flight only.
+func (u *keyUsage) score(now time.Time) float64 {
+	score := float64(u.pending) / 1e6
+	for _, l := range u.limits {
+		if l.Count <= 0 {
+			continue
+		}
+
+		count := u.pending
+		for _, c := range u.counts {
+			if c.Period == l.Period && now.Before(u.countedAt.Add(c.Period)) {
+				count += c.Count
+			}
+		}
+		score = max(score, float64(count)/float64(l.Count))
+	}
+	return score
+}
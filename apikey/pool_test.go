@@ -0,0 +1,200 @@
+package apikey
+
+import (
+	"context"
+	"leago/internal"
+	"leago/internal/mock"
+	"leago/regions"
+	"log/slog"
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	br1 = internal.RequestInfo{Route: "br1"}
+	na1 = internal.RequestInfo{Route: "na1"}
+)
+
+func TestStatic(t *testing.T) {
+	key, err := Static("key").Key(context.Background(), br1)
+	require.NoError(t, err)
+	assert.Equal(t, "key", key)
+
+	_, err = Static("").Key(context.Background(), br1)
+	assert.ErrorIs(t, err, ErrNoKey)
+}
+
+func TestRoundRobin(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	pool := NewRoundRobin([]string{"a", "", "b", "c"}, WithCooldown(time.Minute))
+	pool.now = func() time.Time { return now }
+
+	got := make([]string, 0, 4)
+	for range 4 {
+		key, err := pool.Key(context.Background(), br1)
+		require.NoError(t, err)
+		got = append(got, key)
+	}
+	assert.Equal(t, []string{"a", "b", "c", "a"}, got)
+
+	// Revoked keys are skipped during the cooldown.
+	pool.Observe("b", br1, http.StatusForbidden, nil)
+	got = got[:0]
+	for range 3 {
+		key, err := pool.Key(context.Background(), br1)
+		require.NoError(t, err)
+		got = append(got, key)
+	}
+	assert.Equal(t, []string{"c", "a", "c"}, got)
+
+	pool.Observe("a", br1, http.StatusForbidden, nil)
+	pool.Observe("c", br1, http.StatusForbidden, nil)
+	_, err := pool.Key(context.Background(), br1)
+	assert.ErrorIs(t, err, ErrAllKeysDown)
+
+	now = now.Add(time.Minute)
+	_, err = pool.Key(context.Background(), br1)
+	assert.NoError(t, err)
+}
+
+func TestLeastUsed(t *testing.T) {
+	pool := NewLeastUsed([]string{"a", "b"})
+
+	header := func(count string) http.Header {
+		h := http.Header{}
+		h.Set(internal.HeaderAppRateLimit, "20:1,100:120")
+		h.Set(internal.HeaderAppRateLimitCount, count)
+		return h
+	}
+
+	// Without known limits, keys in flight are avoided.
+	first, err := pool.Key(context.Background(), br1)
+	require.NoError(t, err)
+	second, err := pool.Key(context.Background(), br1)
+	require.NoError(t, err)
+	assert.NotEqual(t, first, second)
+
+	pool.Observe("a", br1, http.StatusOK, header("1:1,90:120"))
+	pool.Observe("b", br1, http.StatusOK, header("10:1,10:120"))
+
+	key, err := pool.Key(context.Background(), br1)
+	require.NoError(t, err)
+	assert.Equal(t, "b", key)
+
+	// Usage is tracked per route.
+	pool.Observe("b", na1, http.StatusOK, header("19:1,1:120"))
+	pool.Observe("a", na1, http.StatusOK, header("1:1,1:120"))
+	key, err = pool.Key(context.Background(), na1)
+	require.NoError(t, err)
+	assert.Equal(t, "a", key)
+
+	_, err = NewLeastUsed(nil).Key(context.Background(), br1)
+	assert.ErrorIs(t, err, ErrNoKey)
+}
+
+func TestLeastUsedCountsExpire(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	pool := NewLeastUsed([]string{"a", "b"})
+	pool.now = func() time.Time { return now }
+
+	header := func(count string) http.Header {
+		h := http.Header{}
+		h.Set(internal.HeaderAppRateLimit, "20:1,100:120")
+		h.Set(internal.HeaderAppRateLimitCount, count)
+		return h
+	}
+
+	pool.Observe("a", br1, http.StatusOK, header("1:1,90:120"))
+	now = now.Add(100 * time.Second)
+	pool.Observe("b", br1, http.StatusOK, header("1:1,30:120"))
+
+	key, err := pool.Key(context.Background(), br1)
+	require.NoError(t, err)
+	assert.Equal(t, "b", key)
+	pool.Release(key, br1)
+
+	// The 120s window of a was reset since its count was observed, b is still counting.
+	now = now.Add(21 * time.Second)
+	key, err = pool.Key(context.Background(), br1)
+	require.NoError(t, err)
+	assert.Equal(t, "a", key)
+}
+
+func TestPoolProductKeys(t *testing.T) {
+	for _, pool := range []*Pool{
+		NewRoundRobin([]string{"shared"}, WithProductKeys(regions.ProductLoL, "lol"), WithProductKeys(regions.ProductTFT, "tft", "lol")),
+		NewLeastUsed([]string{"shared"}, WithProductKeys(regions.ProductLoL, "lol"), WithProductKeys(regions.ProductTFT, "tft", "lol")),
+	} {
+		keysFor := func(product regions.Product) []string {
+			got := make([]string, 0, 6)
+			for range 6 {
+				key, err := pool.Key(context.Background(), internal.RequestInfo{Route: "br1", Product: product})
+				require.NoError(t, err)
+				pool.Observe(key, internal.RequestInfo{Route: "br1", Product: product}, http.StatusOK, nil)
+				got = append(got, key)
+			}
+			return got
+		}
+
+		assert.Subset(t, []string{"shared", "tft", "lol"}, keysFor(regions.ProductTFT))
+		assert.NotContains(t, keysFor(regions.ProductLoL), "tft")
+		assert.Equal(t, []string{"shared"}, slices.Compact(keysFor(regions.ProductVAL)))
+		// Shared APIs like Account-V1 accept a key of any product.
+		assert.Subset(t, []string{"shared", "tft", "lol"}, keysFor(""))
+	}
+
+	tftOnly := NewRoundRobin(nil, WithProductKeys(regions.ProductTFT, "tft"))
+	_, err := tftOnly.Key(context.Background(), internal.RequestInfo{Route: "br1", Product: regions.ProductLoL})
+	assert.ErrorIs(t, err, ErrNoKey)
+
+	// Keys shared between products rotate with the keys of each product.
+	shared := NewRoundRobin([]string{"shared"}, WithProductKeys(regions.ProductTFT, "tft"))
+	tft := internal.RequestInfo{Route: "br1", Product: regions.ProductTFT}
+	got := make([]string, 0, 2)
+	for range 2 {
+		key, err := shared.Key(context.Background(), tft)
+		require.NoError(t, err)
+		got = append(got, key)
+	}
+	assert.Equal(t, []string{"shared", "tft"}, got)
+}
+
+func TestPoolRelease(t *testing.T) {
+	pool := NewLeastUsed([]string{"a", "b"})
+
+	first, err := pool.Key(context.Background(), br1)
+	require.NoError(t, err)
+	pool.Release(first, br1)
+
+	// The released key no longer counts as in flight, so it is picked again.
+	second, err := pool.Key(context.Background(), br1)
+	require.NoError(t, err)
+	assert.Equal(t, first, second)
+}
+
+func TestPoolFailoverBounded(t *testing.T) {
+	// Without a cooldown rejected keys are handed out again, failover must still stop once every key was tried.
+	pool := NewRoundRobin([]string{"a", "b", "c"}, WithCooldown(0))
+	doer := &mock.SequenceDoer{Responses: []*http.Response{mock.NewResponse(http.StatusForbidden, "")}}
+	client := internal.NewHttpClient(doer, slog.Default(), "br1", "", internal.WithKeyProvider(pool))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 300*time.Millisecond)
+	defer cancel()
+
+	_, err := internal.AuthRequest[struct{}](ctx, client, "http://testexample.com")
+
+	var rErr *internal.RiotError
+	require.ErrorAs(t, err, &rErr)
+	assert.Equal(t, http.StatusForbidden, rErr.StatusCode)
+
+	sentKeys := make([]string, 0, len(doer.CapturedReqs))
+	for _, req := range doer.CapturedReqs {
+		sentKeys = append(sentKeys, req.Header.Get("X-Riot-Token"))
+	}
+	assert.Equal(t, []string{"a", "b", "c"}, sentKeys)
+}
@@ -0,0 +1,36 @@
+// Package apikey provides the API key sources used by the clients: static keys, pools and reloading keys.
+package apikey
+
+import (
+	"context"
+	"errors"
+	"leago/internal"
+	"net/http"
+)
+
+type (
+	// Provider supplies the API key of each request and receives the responses sent with it.
+	Provider = internal.KeyProvider
+
+	static string
+)
+
+var (
+	ErrNoKey        = errors.New("apikey: no key available")
+	ErrAllKeysDown  = errors.New("apikey: every key of the pool is disabled")
+	errEmptyKeyFile = errors.New("apikey: empty key file")
+)
+
+// Static returns a provider that always uses the same key.
+func Static(key string) Provider {
+	return static(key)
+}
+
+func (s static) Key(_ context.Context, _ internal.RequestInfo) (string, error) {
+	if s == "" {
+		return "", ErrNoKey
+	}
+	return string(s), nil
+}
+
+func (s static) Observe(_ string, _ internal.RequestInfo, _ int, _ http.Header) {}
@@ -0,0 +1,107 @@
+package apikey
+
+import (
+	"context"
+	"leago/internal"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+type (
+	// File is a key read from a file, reloaded when the file changes or the key is rejected.
+	// Useful for development keys, that expire every 24 hours.
+	File struct {
+		mu       sync.Mutex
+		path     string
+		interval time.Duration
+		key      string
+		modTime  time.Time
+		checked  time.Time
+		stale    bool
+		now      func() time.Time
+	}
+
+	env string
+)
+
+// DefaultReloadInterval is how often the key file is checked for changes.
+const DefaultReloadInterval = 5 * time.Second
+
+// FromFile returns a provider reading the key from the file at path.
+// The file is checked for changes at most once every interval, zero uses DefaultReloadInterval.
+func FromFile(path string, interval time.Duration) *File {
+	if interval <= 0 {
+		interval = DefaultReloadInterval
+	}
+
+	return &File{
+		path:     path,
+		interval: interval,
+		now:      time.Now,
+	}
+}
+
+// FromEnv returns a provider reading the key from the environment variable on every request.
+func FromEnv(name string) Provider {
+	return env(name)
+}
+
+// Key returns the current key, reloading the file when needed.
+func (f *File) Key(_ context.Context, _ internal.RequestInfo) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	now := f.now()
+	if f.key != "" && !f.stale && now.Sub(f.checked) < f.interval {
+		return f.key, nil
+	}
+	f.checked = now
+
+	info, err := os.Stat(f.path)
+	if err != nil {
+		return "", err
+	}
+
+	if f.key != "" && !f.stale && info.ModTime().Equal(f.modTime) {
+		return f.key, nil
+	}
+
+	data, err := os.ReadFile(f.path)
+	if err != nil {
+		return "", err
+	}
+
+	key := strings.TrimSpace(string(data))
+	if key == "" {
+		return "", errEmptyKeyFile
+	}
+
+	f.key, f.modTime, f.stale = key, info.ModTime(), false
+	return f.key, nil
+}
+
+// Observe forces a reload on the next request when the key is rejected.
+func (f *File) Observe(key string, _ internal.RequestInfo, statusCode int, _ http.Header) {
+	if statusCode != http.StatusUnauthorized && statusCode != http.StatusForbidden {
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if key == f.key {
+		f.stale = true
+	}
+}
+
+func (e env) Key(_ context.Context, _ internal.RequestInfo) (string, error) {
+	key := strings.TrimSpace(os.Getenv(string(e)))
+	if key == "" {
+		return "", ErrNoKey
+	}
+	return key, nil
+}
+
+func (e env) Observe(_ string, _ internal.RequestInfo, _ int, _ http.Header) {}
@@ -0,0 +1,69 @@
+package apikey
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "key")
+	require.NoError(t, os.WriteFile(path, []byte("RGAPI-first\n"), 0o600))
+
+	now := time.Unix(1700000000, 0)
+	provider := FromFile(path, time.Minute)
+	provider.now = func() time.Time { return now }
+
+	key, err := provider.Key(context.Background(), br1)
+	require.NoError(t, err)
+	assert.Equal(t, "RGAPI-first", key)
+
+	// Changes are only picked after the interval.
+	require.NoError(t, os.WriteFile(path, []byte("RGAPI-second"), 0o600))
+	require.NoError(t, os.Chtimes(path, now, now.Add(time.Second)))
+
+	key, err = provider.Key(context.Background(), br1)
+	require.NoError(t, err)
+	assert.Equal(t, "RGAPI-first", key)
+
+	now = now.Add(time.Minute)
+	key, err = provider.Key(context.Background(), br1)
+	require.NoError(t, err)
+	assert.Equal(t, "RGAPI-second", key)
+
+	// A rejected key forces a reload.
+	require.NoError(t, os.WriteFile(path, []byte("RGAPI-third"), 0o600))
+	provider.Observe("RGAPI-second", br1, http.StatusForbidden, nil)
+	key, err = provider.Key(context.Background(), br1)
+	require.NoError(t, err)
+	assert.Equal(t, "RGAPI-third", key)
+}
+
+func TestFromFileErrors(t *testing.T) {
+	_, err := FromFile(filepath.Join(t.TempDir(), "missing"), 0).Key(context.Background(), br1)
+	assert.Error(t, err)
+
+	path := filepath.Join(t.TempDir(), "empty")
+	require.NoError(t, os.WriteFile(path, []byte("  \n"), 0o600))
+	_, err = FromFile(path, 0).Key(context.Background(), br1)
+	assert.Error(t, err)
+}
+
+func TestFromEnv(t *testing.T) {
+	provider := FromEnv("LEAGO_TEST_API_KEY")
+
+	t.Setenv("LEAGO_TEST_API_KEY", "")
+	_, err := provider.Key(context.Background(), br1)
+	assert.ErrorIs(t, err, ErrNoKey)
+
+	t.Setenv("LEAGO_TEST_API_KEY", "RGAPI-env")
+	key, err := provider.Key(context.Background(), br1)
+	require.NoError(t, err)
+	assert.Equal(t, "RGAPI-env", key)
+}
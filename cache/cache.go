@@ -0,0 +1,31 @@
+// Package cache defines the storage used to cache raw Riot API responses.
+package cache
+
+import "time"
+
+type (
+	// Cache stores raw response bodies keyed by route and URL.
+	// Implementations must be safe for concurrent use.
+	Cache interface {
+		// Get returns the entry for the key, expired entries included, so they can be served when Riot is down.
+		Get(key string) (Entry, bool)
+		// Set stores the value for the key, expiring after the ttl.
+		Set(key string, value []byte, ttl time.Duration)
+	}
+
+	// Entry is a cached response body.
+	Entry struct {
+		Value   []byte
+		Expires time.Time
+	}
+)
+
+// Expired returns if the entry ttl has passed.
+func (e Entry) Expired(now time.Time) bool {
+	return !now.Before(e.Expires)
+}
+
+// TTL returns how long until the entry expires, negative if already expired.
+func (e Entry) TTL(now time.Time) time.Duration {
+	return e.Expires.Sub(now)
+}
@@ -0,0 +1,75 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+type (
+	// LRU is a bounded in-memory cache, evicting the least recently used entries.
+	// Expired entries are kept until evicted, allowing them to be served as stale.
+	LRU struct {
+		mu         sync.Mutex
+		maxEntries int
+		order      *list.List
+		items      map[string]*list.Element
+		now        func() time.Time
+	}
+
+	lruItem struct {
+		key   string
+		entry Entry
+	}
+)
+
+// NewLRU returns a cache holding at most maxEntries responses.
+func NewLRU(maxEntries int) *LRU {
+	return &LRU{
+		maxEntries: max(maxEntries, 1),
+		order:      list.New(),
+		items:      make(map[string]*list.Element),
+		now:        time.Now,
+	}
+}
+
+// Get returns the entry for the key, marking it as recently used.
+func (c *LRU) Get(key string) (Entry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[key]
+	if !ok {
+		return Entry{}, false
+	}
+
+	c.order.MoveToFront(el)
+	return el.Value.(*lruItem).entry, true
+}
+
+// Set stores the value for the key, evicting the oldest entry when full.
+func (c *LRU) Set(key string, value []byte, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry := Entry{Value: value, Expires: c.now().Add(ttl)}
+	if el, ok := c.items[key]; ok {
+		el.Value.(*lruItem).entry = entry
+		c.order.MoveToFront(el)
+		return
+	}
+
+	c.items[key] = c.order.PushFront(&lruItem{key: key, entry: entry})
+	for c.order.Len() > c.maxEntries {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*lruItem).key)
+	}
+}
+
+// Len returns the number of entries, expired ones included.
+func (c *LRU) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
@@ -0,0 +1,54 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLRU(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	c := NewLRU(2)
+	c.now = func() time.Time { return now }
+
+	c.Set("a", []byte("1"), time.Minute)
+	c.Set("b", []byte("2"), time.Minute)
+
+	// Touch "a" so "b" becomes the least recently used.
+	_, ok := c.Get("a")
+	require.True(t, ok)
+
+	c.Set("c", []byte("3"), time.Minute)
+	assert.Equal(t, 2, c.Len())
+
+	_, ok = c.Get("b")
+	assert.False(t, ok)
+
+	entry, ok := c.Get("a")
+	require.True(t, ok)
+	assert.Equal(t, []byte("1"), entry.Value)
+	assert.False(t, entry.Expired(now))
+	assert.Equal(t, time.Minute, entry.TTL(now))
+
+	// Expired entries are still returned.
+	now = now.Add(2 * time.Minute)
+	entry, ok = c.Get("c")
+	require.True(t, ok)
+	assert.True(t, entry.Expired(now))
+
+	// Overwrite refreshes the value and ttl.
+	c.Set("c", []byte("4"), time.Minute)
+	entry, ok = c.Get("c")
+	require.True(t, ok)
+	assert.Equal(t, []byte("4"), entry.Value)
+	assert.False(t, entry.Expired(now))
+}
+
+func TestNewLRUMinimumSize(t *testing.T) {
+	c := NewLRU(0)
+	c.Set("a", nil, time.Minute)
+	c.Set("b", nil, time.Minute)
+	assert.Equal(t, 1, c.Len())
+}
@@ -0,0 +1,130 @@
+package leago
+
+import (
+	"leago/api/lol/challenges"
+	"leago/api/lol/champion"
+	"leago/api/lol/championmastery"
+	"leago/api/lol/clash"
+	"leago/api/lol/league"
+	"leago/api/lol/leagueexp"
+	"leago/api/lol/match"
+	"leago/api/lol/spectator"
+	"leago/api/lol/status"
+	"leago/api/lol/summoner"
+	lormatch "leago/api/lor/match"
+	lorranked "leago/api/lor/ranked"
+	lorstatus "leago/api/lor/status"
+	"leago/api/riot/account"
+	tftleague "leago/api/tft/league"
+	tftmatch "leago/api/tft/match"
+	tftspectator "leago/api/tft/spectator"
+	tftstatus "leago/api/tft/status"
+	tftsummoner "leago/api/tft/summoner"
+	valcontent "leago/api/val/content"
+	valmatch "leago/api/val/match"
+	valranked "leago/api/val/ranked"
+	valstatus "leago/api/val/status"
+	"leago/ddragon"
+	"maps"
+	"time"
+)
+
+// defaultCacheTTLs are the cache TTLs for each API method, methods not listed aren't cached by default.
+var defaultCacheTTLs = map[string]time.Duration{
+	challenges.MethodGetConfig:                          time.Hour,
+	challenges.MethodGetConfigByID:                      time.Hour,
+	challenges.MethodGetLeaderboardByChallengeIDByLevel: 10 * time.Minute,
+	challenges.MethodGetPercentiles:                     time.Hour,
+	challenges.MethodGetPercentilesByChallengeID:        time.Hour,
+	challenges.MethodGetPlayerInfoByPUUID:               5 * time.Minute,
+
+	champion.MethodGetRotation: time.Hour,
+
+	championmastery.MethodGetByPUUID:           10 * time.Minute,
+	championmastery.MethodGetByPUUIDTop:        10 * time.Minute,
+	championmastery.MethodGetByPUUIDByChampion: 10 * time.Minute,
+	championmastery.MethodGetScoreByPUUID:      10 * time.Minute,
+
+	clash.MethodGetPlayerByPUUID:      5 * time.Minute,
+	clash.MethodGetTeamByID:           5 * time.Minute,
+	clash.MethodGetTournaments:        time.Hour,
+	clash.MethodGetTournamentByTeamID: time.Hour,
+	clash.MethodGetTournamentByID:     time.Hour,
+
+	league.MethodGetChallengerLeague:     10 * time.Minute,
+	league.MethodGetGrandmasterLeague:    10 * time.Minute,
+	league.MethodGetMasterLeague:         10 * time.Minute,
+	league.MethodGetLeagueEntries:        5 * time.Minute,
+	league.MethodGetLeagueEntriesByPUUID: 5 * time.Minute,
+	league.MethodGetLeagueByID:           5 * time.Minute,
+
+	leagueexp.MethodGetLeague: 5 * time.Minute,
+
+	// Finished matches never change, only the list of IDs grows.
+	match.MethodGetMatchIDsByPUUID: time.Minute,
+	match.MethodGetMatch:           24 * time.Hour,
+	match.MethodGetTimeline:        24 * time.Hour,
+
+	spectator.MethodGetFeaturedGames: 2 * time.Minute,
+
+	status.MethodGetPlatformData: time.Minute,
+
+	summoner.MethodGetByPUUID:     10 * time.Minute,
+	summoner.MethodGetByAccountID: 10 * time.Minute,
+
+	account.MethodGetActiveRegionByPUUID: 10 * time.Minute,
+	account.MethodGetActiveShardByPUUID:  10 * time.Minute,
+	account.MethodGetByPUUID:             time.Hour,
+	account.MethodGetByRiotID:            time.Hour,
+
+	tftleague.MethodGetLeagueEntriesByPUUID: 5 * time.Minute,
+	tftleague.MethodGetChallengerLeague:     10 * time.Minute,
+	tftleague.MethodGetGrandmasterLeague:    10 * time.Minute,
+	tftleague.MethodGetMasterLeague:         10 * time.Minute,
+	tftleague.MethodGetLeagueEntries:        5 * time.Minute,
+	tftleague.MethodGetLeagueByID:           5 * time.Minute,
+	tftleague.MethodGetTopRatedLadder:       5 * time.Minute,
+
+	tftmatch.MethodGetMatchIDsByPUUID: time.Minute,
+	tftmatch.MethodGetMatch:           24 * time.Hour,
+
+	tftspectator.MethodGetFeaturedGames: 2 * time.Minute,
+
+	tftstatus.MethodGetPlatformData: time.Minute,
+
+	tftsummoner.MethodGetByPUUID:     10 * time.Minute,
+	tftsummoner.MethodGetByAccountID: 10 * time.Minute,
+
+	lormatch.MethodGetMatchIDsByPUUID: time.Minute,
+	lormatch.MethodGetMatch:           24 * time.Hour,
+
+	lorranked.MethodGetLeaderboard: 10 * time.Minute,
+
+	lorstatus.MethodGetPlatformData: time.Minute,
+
+	valcontent.MethodGetContent: time.Hour,
+
+	valmatch.MethodGetMatch:                24 * time.Hour,
+	valmatch.MethodGetMatchlistByPUUID:     time.Minute,
+	valmatch.MethodGetRecentMatchesByQueue: 30 * time.Second,
+
+	valranked.MethodGetLeaderboardByAct: 10 * time.Minute,
+
+	valstatus.MethodGetPlatformData: time.Minute,
+
+	// Data Dragon files are immutable for a version, only the versions and realms change on patches.
+	ddragon.MethodGetVersions:       time.Hour,
+	ddragon.MethodGetRealm:          time.Hour,
+	ddragon.MethodGetChampions:      24 * time.Hour,
+	ddragon.MethodGetChampion:       24 * time.Hour,
+	ddragon.MethodGetItems:          24 * time.Hour,
+	ddragon.MethodGetRunesReforged:  24 * time.Hour,
+	ddragon.MethodGetSummonerSpells: 24 * time.Hour,
+	ddragon.MethodGetProfileIcons:   24 * time.Hour,
+	ddragon.MethodGetMaps:           24 * time.Hour,
+}
+
+// DefaultCacheTTLs returns a copy of the TTLs used by WithCache, keyed by the Method constants of each API.
+func DefaultCacheTTLs() map[string]time.Duration {
+	return maps.Clone(defaultCacheTTLs)
+}
@@ -0,0 +1,109 @@
+package leago
+
+import (
+	"leago/ddragon"
+	"leago/regions"
+	"sync"
+)
+
+// Client creates platform, region and shard clients on demand.
+// Every sub-client shares the same http client, rate limiter, cache and logger.
+type Client struct {
+	*baseClient
+	apiKey string
+
+	mu        sync.Mutex
+	platforms map[regions.Platform]*PlatformClient
+	regions   map[regions.Region]*RegionClient
+	shards    map[regions.Shard]*ShardClient
+
+	dataDragon *ddragon.Client
+}
+
+// New returns a client able to reach every platform and region with the same API key and options.
+func New(apiKey string, opts ...Option) *Client {
+	return &Client{
+		baseClient: newBaseClient(opts...),
+		apiKey:     apiKey,
+		platforms:  make(map[regions.Platform]*PlatformClient),
+		regions:    make(map[regions.Region]*RegionClient),
+		shards:     make(map[regions.Shard]*ShardClient),
+	}
+}
+
+// Platform returns the client for the platform, creating it on the first call.
+func (c *Client) Platform(platform regions.Platform) *PlatformClient {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	pc, ok := c.platforms[platform]
+	if !ok {
+		pc = newPlatformClient(c.baseClient, platform, c.apiKey)
+		c.platforms[platform] = pc
+	}
+	return pc
+}
+
+// Region returns the client for the region, creating it on the first call.
+func (c *Client) Region(region regions.Region) *RegionClient {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rc, ok := c.regions[region]
+	if !ok {
+		rc = newRegionClient(c.baseClient, region, c.apiKey)
+		c.regions[region] = rc
+	}
+	return rc
+}
+
+// Shard returns the client for the VALORANT shard, creating it on the first call.
+func (c *Client) Shard(shard regions.Shard) *ShardClient {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	sc, ok := c.shards[shard]
+	if !ok {
+		sc = newShardClient(c.baseClient, shard, c.apiKey)
+		c.shards[shard] = sc
+	}
+	return sc
+}
+
+// DataDragon returns the Data Dragon client, creating it on the first call.
+func (c *Client) DataDragon() *ddragon.Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.dataDragon == nil {
+		c.dataDragon = c.newDataDragonClient()
+	}
+	return c.dataDragon
+}
+
+// RegionFor returns the client of the regional route serving the LoL and TFT match APIs of the platform, like euw1 -> europe.
+// Use AccountRegionFor for the account API and RegionForProduct for LoR, their routes differ for some platforms.
+// Returns nil for unknown platforms.
+func (c *Client) RegionFor(platform regions.Platform) *RegionClient {
+	return c.RegionForProduct(platform, regions.ProductLoL)
+}
+
+// RegionForProduct returns the client of the regional route serving the product APIs of the platform, like kr -> sea for LoR.
+// Returns nil for unknown platforms or products.
+func (c *Client) RegionForProduct(platform regions.Platform, product regions.Product) *RegionClient {
+	return c.regionClient(platform.RegionFor(product))
+}
+
+// AccountRegionFor returns the client of the regional route serving the account API of the platform, like vn2 -> asia.
+// Returns nil for unknown platforms.
+func (c *Client) AccountRegionFor(platform regions.Platform) *RegionClient {
+	return c.regionClient(platform.AccountRegion())
+}
+
+// regionClient returns the client of the region, or nil for an empty region.
+func (c *Client) regionClient(region regions.Region) *RegionClient {
+	if region == "" {
+		return nil
+	}
+	return c.Region(region)
+}
@@ -0,0 +1,100 @@
+package leago_test
+
+import (
+	"context"
+	"leago"
+	"leago/internal/mock"
+	"leago/regions"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientPlatform(t *testing.T) {
+	client := leago.New("ApiKey")
+
+	br1 := client.Platform(regions.PlatformBR1)
+	require.NotNil(t, br1)
+	require.NotNil(t, br1.Lol)
+	require.NotNil(t, br1.Tft)
+	assert.Same(t, br1, client.Platform(regions.PlatformBR1))
+	assert.NotSame(t, br1, client.Platform(regions.PlatformNA1))
+}
+
+func TestClientRegionFor(t *testing.T) {
+	client := leago.New("ApiKey")
+
+	europe := client.RegionFor(regions.PlatformEUW1)
+	require.NotNil(t, europe)
+	require.NotNil(t, europe.Lol)
+	require.NotNil(t, europe.Tft)
+	require.NotNil(t, europe.Lor)
+	assert.Same(t, client.Region(regions.RegionEurope), europe)
+	assert.Same(t, client.Region(regions.RegionSEA), client.RegionFor(regions.PlatformVN2))
+	assert.Nil(t, client.RegionFor(regions.Platform("unknown")))
+
+	assert.Same(t, client.Region(regions.RegionAsia), client.AccountRegionFor(regions.PlatformVN2))
+	assert.Nil(t, client.AccountRegionFor(regions.Platform("unknown")))
+
+	assert.Same(t, client.Region(regions.RegionSEA), client.RegionForProduct(regions.PlatformJP1, regions.ProductLoR))
+	assert.Same(t, client.Region(regions.RegionAsia), client.RegionForProduct(regions.PlatformJP1, regions.ProductTFT))
+	assert.Nil(t, client.RegionForProduct(regions.PlatformJP1, regions.Product("2xko")))
+}
+
+func TestClientShard(t *testing.T) {
+	client := leago.New("ApiKey")
+
+	na := client.Shard(regions.ShardNA)
+	require.NotNil(t, na)
+	require.NotNil(t, na.Val)
+	assert.Same(t, na, client.Shard(regions.ShardNA))
+	assert.NotSame(t, na, client.Shard(regions.ShardEU))
+}
+
+func TestClientDataDragon(t *testing.T) {
+	client := leago.New("ApiKey")
+
+	dd := client.DataDragon()
+	require.NotNil(t, dd)
+	assert.Same(t, dd, client.DataDragon())
+}
+
+func TestClientSharesTransport(t *testing.T) {
+	doer := &mock.SequenceDoer{
+		Responses: []*http.Response{
+			mock.NewResponse(http.StatusOK, `{"maxNewPlayerLevel":10}`),
+			mock.NewResponse(http.StatusOK, `{"puuid":"puuid","gameName":"name","tagLine":"tag"}`),
+		},
+	}
+	client := leago.New("ApiKey", leago.WithClient(doer))
+
+	_, err := client.Platform(regions.PlatformEUW1).Lol.Champion.GetRotation(context.Background())
+	require.NoError(t, err)
+
+	_, err = client.AccountRegionFor(regions.PlatformEUW1).Riot.Account.GetByPUUID(context.Background(), "puuid")
+	require.NoError(t, err)
+
+	require.Len(t, doer.CapturedReqs, 2)
+	assert.Equal(t, "euw1.api.riotgames.com", doer.CapturedReqs[0].URL.Host)
+	assert.Equal(t, "europe.api.riotgames.com", doer.CapturedReqs[1].URL.Host)
+}
+
+func TestClientConcurrentAccess(t *testing.T) {
+	client := leago.New("ApiKey")
+
+	var wg sync.WaitGroup
+	got := make([]*leago.PlatformClient, 10)
+	for i := range got {
+		wg.Go(func() {
+			got[i] = client.Platform(regions.PlatformKR)
+		})
+	}
+	wg.Wait()
+
+	for _, pc := range got {
+		assert.Same(t, got[0], pc)
+	}
+}
@@ -0,0 +1,40 @@
+package ddragon
+
+import (
+	"leago/internal"
+	"log/slog"
+	"strings"
+)
+
+// Client loads the static data of Data Dragon, the CDN of the LoL game data and images.
+type Client struct {
+	client  *internal.Client
+	baseURL string
+}
+
+const (
+	// DefaultBaseURL is the Data Dragon CDN.
+	DefaultBaseURL = "https://ddragon.leagueoflegends.com"
+
+	// route identifies the Data Dragon requests on the logs, cache keys and rate limiter.
+	route = "ddragon"
+)
+
+// NewClient returns a Data Dragon client, an empty baseURL uses the DefaultBaseURL.
+// The baseURL can point to a local mirror with the same layout as the CDN.
+// The API key is never sent, Data Dragon is public.
+func NewClient(client internal.Doer, logger *slog.Logger, baseURL string, opts ...internal.ClientOption) *Client {
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+	baseURL = strings.TrimSuffix(baseURL, "/")
+
+	opts = append(opts, internal.WithBaseURL(func(string) string {
+		return baseURL
+	}))
+
+	return &Client{
+		client:  internal.NewHttpClient(client, logger, route, "", opts...),
+		baseURL: baseURL,
+	}
+}
@@ -0,0 +1,73 @@
+package ddragon
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// ImageURL returns the URL of the image of any data entry, like a champion, item or summoner spell.
+// Like every versioned builder, it needs a concrete version, like the Version of the loaded data.
+// VersionLatest isn't served by the CDN, it builds an empty URL.
+func (c *Client) ImageURL(version string, image Image) string {
+	return versionedURL(c.baseURL, version, "img", image.Group, image.Full)
+}
+
+// SpriteURL returns the URL of the sprite holding the image, positioned by its X, Y, W and H.
+func (c *Client) SpriteURL(version string, image Image) string {
+	return versionedURL(c.baseURL, version, "img", "sprite", image.Sprite)
+}
+
+// ChampionSquareURL returns the URL of the champion square icon, got by the champion ID like "MonkeyKing".
+func (c *Client) ChampionSquareURL(version, championID string) string {
+	return versionedURL(c.baseURL, version, "img", "champion", championID+".png")
+}
+
+// ChampionSplashURL returns the URL of the splash art of the champion skin, 0 being the default skin.
+// Splash arts aren't versioned.
+func (c *Client) ChampionSplashURL(championID string, skinNum int) string {
+	return c.baseURL + "/cdn/img/champion/splash/" + url.PathEscape(skinFile(championID, skinNum))
+}
+
+// ChampionLoadingURL returns the URL of the loading screen art of the champion skin, 0 being the default skin.
+func (c *Client) ChampionLoadingURL(championID string, skinNum int) string {
+	return c.baseURL + "/cdn/img/champion/loading/" + url.PathEscape(skinFile(championID, skinNum))
+}
+
+// ItemImageURL returns the URL of the item icon.
+func (c *Client) ItemImageURL(version string, itemID int) string {
+	return versionedURL(c.baseURL, version, "img", "item", strconv.Itoa(itemID)+".png")
+}
+
+// ProfileIconURL returns the URL of the profile icon, like the summoner ProfileIconID.
+func (c *Client) ProfileIconURL(version string, iconID int) string {
+	return versionedURL(c.baseURL, version, "img", "profileicon", strconv.Itoa(iconID)+".png")
+}
+
+// MapImageURL returns the URL of the minimap of the map.
+func (c *Client) MapImageURL(version string, mapID int) string {
+	return versionedURL(c.baseURL, version, "img", "map", fmt.Sprintf("map%d.png", mapID))
+}
+
+// RuneIconURL returns the URL of the icon of a rune or rune path, the icon path is not versioned.
+func (c *Client) RuneIconURL(icon string) string {
+	return c.baseURL + "/cdn/img/" + icon
+}
+
+// versionedURL joins the escaped segments under the versioned CDN path.
+// Returns an empty URL for VersionLatest and empty versions.
+func versionedURL(baseURL, version string, segments ...string) string {
+	if version == "" || version == VersionLatest {
+		return ""
+	}
+
+	u := baseURL + "/cdn/" + url.PathEscape(version)
+	for _, s := range segments {
+		u += "/" + url.PathEscape(s)
+	}
+	return u
+}
+
+func skinFile(championID string, skinNum int) string {
+	return fmt.Sprintf("%s_%d.jpg", championID, skinNum)
+}
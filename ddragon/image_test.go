@@ -0,0 +1,87 @@
+package ddragon
+
+import (
+	"log/slog"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImageURLs(t *testing.T) {
+	c := NewClient(http.DefaultClient, slog.Default(), "")
+	mirror := NewClient(http.DefaultClient, slog.Default(), "http://localhost:8080/")
+
+	tests := []struct {
+		name     string
+		got      string
+		expected string
+	}{
+		{
+			name:     "image",
+			got:      c.ImageURL("14.1.1", Image{Full: "SummonerFlash.png", Group: "spell"}),
+			expected: "https://ddragon.leagueoflegends.com/cdn/14.1.1/img/spell/SummonerFlash.png",
+		},
+		{
+			name:     "sprite",
+			got:      c.SpriteURL("14.1.1", Image{Sprite: "champion2.png"}),
+			expected: "https://ddragon.leagueoflegends.com/cdn/14.1.1/img/sprite/champion2.png",
+		},
+		{
+			name:     "champion square",
+			got:      c.ChampionSquareURL("14.1.1", "MonkeyKing"),
+			expected: "https://ddragon.leagueoflegends.com/cdn/14.1.1/img/champion/MonkeyKing.png",
+		},
+		{
+			name:     "champion splash",
+			got:      c.ChampionSplashURL("MonkeyKing", 5),
+			expected: "https://ddragon.leagueoflegends.com/cdn/img/champion/splash/MonkeyKing_5.jpg",
+		},
+		{
+			name:     "champion loading",
+			got:      c.ChampionLoadingURL("MonkeyKing", 0),
+			expected: "https://ddragon.leagueoflegends.com/cdn/img/champion/loading/MonkeyKing_0.jpg",
+		},
+		{
+			name:     "item",
+			got:      c.ItemImageURL("14.1.1", 1001),
+			expected: "https://ddragon.leagueoflegends.com/cdn/14.1.1/img/item/1001.png",
+		},
+		{
+			name:     "profile icon",
+			got:      c.ProfileIconURL("14.1.1", 29),
+			expected: "https://ddragon.leagueoflegends.com/cdn/14.1.1/img/profileicon/29.png",
+		},
+		{
+			name:     "map",
+			got:      c.MapImageURL("14.1.1", 11),
+			expected: "https://ddragon.leagueoflegends.com/cdn/14.1.1/img/map/map11.png",
+		},
+		{
+			name:     "rune icon",
+			got:      c.RuneIconURL("perk-images/Styles/7201_Precision.png"),
+			expected: "https://ddragon.leagueoflegends.com/cdn/img/perk-images/Styles/7201_Precision.png",
+		},
+		{
+			name:     "local mirror",
+			got:      mirror.ProfileIconURL("14.1.1", 29),
+			expected: "http://localhost:8080/cdn/14.1.1/img/profileicon/29.png",
+		},
+		{
+			name:     "latest version is not served",
+			got:      c.ChampionSquareURL(VersionLatest, "MonkeyKing"),
+			expected: "",
+		},
+		{
+			name:     "empty version",
+			got:      c.ItemImageURL("", 1001),
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.got)
+		})
+	}
+}
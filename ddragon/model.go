@@ -0,0 +1,262 @@
+package ddragon
+
+type (
+	// Realm is the versions served to the players of a realm, like na or euw.
+	Realm struct {
+		// Version is the realm version.
+		Version string `json:"v"`
+		// Locale is the default locale of the realm.
+		Locale Locale `json:"l"`
+		// CDN is the base URL of the CDN serving the realm.
+		CDN string `json:"cdn"`
+		// DataDragonVersion is the latest Data Dragon version of the realm.
+		DataDragonVersion string `json:"dd"`
+		LegacyVersion     string `json:"lg"`
+		CSSVersion        string `json:"css"`
+		ProfileIconMax    int    `json:"profileiconmax"`
+		// Versions are the Data Dragon version of each data type, like "champion" or "item".
+		Versions map[string]string `json:"n"`
+	}
+
+	// List is the common envelope of the Data Dragon data files, keyed by the entry ID.
+	List[T any] struct {
+		Type    string       `json:"type"`
+		Format  string       `json:"format"`
+		Version string       `json:"version"`
+		Data    map[string]T `json:"data"`
+	}
+
+	Image struct {
+		Full   string `json:"full"`
+		Sprite string `json:"sprite"`
+		Group  string `json:"group"`
+		X      int    `json:"x"`
+		Y      int    `json:"y"`
+		W      int    `json:"w"`
+		H      int    `json:"h"`
+	}
+
+	Champion struct {
+		Version string `json:"version"`
+		// ID is the champion name used on the data files and images, like "MonkeyKing".
+		ID string `json:"id"`
+		// Key is the champion ID used by the Riot API, like 62.
+		Key     int           `json:"key,string"`
+		Name    string        `json:"name"`
+		Title   string        `json:"title"`
+		Blurb   string        `json:"blurb"`
+		Info    ChampionInfo  `json:"info"`
+		Image   Image         `json:"image"`
+		Tags    []string      `json:"tags"`
+		Partype string        `json:"partype"`
+		Stats   ChampionStats `json:"stats"`
+	}
+
+	ChampionInfo struct {
+		Attack     int `json:"attack"`
+		Defense    int `json:"defense"`
+		Magic      int `json:"magic"`
+		Difficulty int `json:"difficulty"`
+	}
+
+	ChampionStats struct {
+		HP                   float64 `json:"hp"`
+		HPPerLevel           float64 `json:"hpperlevel"`
+		MP                   float64 `json:"mp"`
+		MPPerLevel           float64 `json:"mpperlevel"`
+		MoveSpeed            float64 `json:"movespeed"`
+		Armor                float64 `json:"armor"`
+		ArmorPerLevel        float64 `json:"armorperlevel"`
+		SpellBlock           float64 `json:"spellblock"`
+		SpellBlockPerLevel   float64 `json:"spellblockperlevel"`
+		AttackRange          float64 `json:"attackrange"`
+		HPRegen              float64 `json:"hpregen"`
+		HPRegenPerLevel      float64 `json:"hpregenperlevel"`
+		MPRegen              float64 `json:"mpregen"`
+		MPRegenPerLevel      float64 `json:"mpregenperlevel"`
+		Crit                 float64 `json:"crit"`
+		CritPerLevel         float64 `json:"critperlevel"`
+		AttackDamage         float64 `json:"attackdamage"`
+		AttackDamagePerLevel float64 `json:"attackdamageperlevel"`
+		AttackSpeedPerLevel  float64 `json:"attackspeedperlevel"`
+		AttackSpeed          float64 `json:"attackspeed"`
+	}
+
+	// ChampionDetail is the full champion data, with the skins, lore, spells and passive.
+	ChampionDetail struct {
+		Champion
+		Skins     []Skin          `json:"skins"`
+		Lore      string          `json:"lore"`
+		AllyTips  []string        `json:"allytips"`
+		EnemyTips []string        `json:"enemytips"`
+		Spells    []ChampionSpell `json:"spells"`
+		Passive   Passive         `json:"passive"`
+	}
+
+	Skin struct {
+		ID      string `json:"id"`
+		Num     int    `json:"num"`
+		Name    string `json:"name"`
+		Chromas bool   `json:"chromas"`
+	}
+
+	ChampionSpell struct {
+		ID           string    `json:"id"`
+		Name         string    `json:"name"`
+		Description  string    `json:"description"`
+		Tooltip      string    `json:"tooltip"`
+		MaxRank      int       `json:"maxrank"`
+		Cooldown     []float64 `json:"cooldown"`
+		CooldownBurn string    `json:"cooldownBurn"`
+		Cost         []float64 `json:"cost"`
+		CostBurn     string    `json:"costBurn"`
+		CostType     string    `json:"costType"`
+		MaxAmmo      string    `json:"maxammo"`
+		Range        []float64 `json:"range"`
+		RangeBurn    string    `json:"rangeBurn"`
+		Image        Image     `json:"image"`
+		Resource     string    `json:"resource"`
+	}
+
+	Passive struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+		Image       Image  `json:"image"`
+	}
+
+	ItemList struct {
+		List[Item]
+		Groups []ItemGroup `json:"groups"`
+		Tree   []ItemTree  `json:"tree"`
+	}
+
+	Item struct {
+		Name             string             `json:"name"`
+		Description      string             `json:"description"`
+		Colloq           string             `json:"colloq"`
+		Plaintext        string             `json:"plaintext"`
+		Into             []string           `json:"into"`
+		From             []string           `json:"from"`
+		Image            Image              `json:"image"`
+		Gold             ItemGold           `json:"gold"`
+		Tags             []string           `json:"tags"`
+		Maps             map[string]bool    `json:"maps"`
+		Stats            map[string]float64 `json:"stats"`
+		Depth            int                `json:"depth"`
+		InStore          *bool              `json:"inStore,omitempty"`
+		RequiredChampion string             `json:"requiredChampion,omitempty"`
+		SpecialRecipe    int                `json:"specialRecipe,omitempty"`
+	}
+
+	ItemGold struct {
+		Base        int  `json:"base"`
+		Purchasable bool `json:"purchasable"`
+		Total       int  `json:"total"`
+		Sell        int  `json:"sell"`
+	}
+
+	ItemGroup struct {
+		ID              string `json:"id"`
+		MaxGroupOwnable string `json:"MaxGroupOwnable"`
+	}
+
+	ItemTree struct {
+		Header string   `json:"header"`
+		Tags   []string `json:"tags"`
+	}
+
+	// RunePath is a rune tree, like Precision or Domination.
+	RunePath struct {
+		ID    int        `json:"id"`
+		Key   string     `json:"key"`
+		Icon  string     `json:"icon"`
+		Name  string     `json:"name"`
+		Slots []RuneSlot `json:"slots"`
+	}
+
+	RuneSlot struct {
+		Runes []Rune `json:"runes"`
+	}
+
+	Rune struct {
+		ID        int    `json:"id"`
+		Key       string `json:"key"`
+		Icon      string `json:"icon"`
+		Name      string `json:"name"`
+		ShortDesc string `json:"shortDesc"`
+		LongDesc  string `json:"longDesc"`
+	}
+
+	SummonerSpell struct {
+		ID           string    `json:"id"`
+		Name         string    `json:"name"`
+		Description  string    `json:"description"`
+		Tooltip      string    `json:"tooltip"`
+		MaxRank      int       `json:"maxrank"`
+		Cooldown     []float64 `json:"cooldown"`
+		CooldownBurn string    `json:"cooldownBurn"`
+		Cost         []float64 `json:"cost"`
+		CostBurn     string    `json:"costBurn"`
+		// Key is the spell ID used by the Riot API, like 4 for Flash.
+		Key           int       `json:"key,string"`
+		SummonerLevel int       `json:"summonerLevel"`
+		Modes         []string  `json:"modes"`
+		CostType      string    `json:"costType"`
+		MaxAmmo       string    `json:"maxammo"`
+		Range         []float64 `json:"range"`
+		RangeBurn     string    `json:"rangeBurn"`
+		Image         Image     `json:"image"`
+		Resource      string    `json:"resource"`
+	}
+
+	ProfileIcon struct {
+		ID    int   `json:"id"`
+		Image Image `json:"image"`
+	}
+
+	Map struct {
+		MapName string `json:"MapName"`
+		MapID   string `json:"MapId"`
+		Image   Image  `json:"image"`
+	}
+
+	Locale string
+)
+
+const (
+	// VersionLatest resolves to the latest Data Dragon version when passed to the loaders.
+	// The image URL builders don't resolve it, they need a concrete version.
+	VersionLatest = "latest"
+
+	LocaleArAE Locale = "ar_AE"
+	LocaleCsCZ Locale = "cs_CZ"
+	LocaleDeDE Locale = "de_DE"
+	LocaleElGR Locale = "el_GR"
+	LocaleEnAU Locale = "en_AU"
+	LocaleEnGB Locale = "en_GB"
+	LocaleEnPH Locale = "en_PH"
+	LocaleEnSG Locale = "en_SG"
+	LocaleEnUS Locale = "en_US"
+	LocaleEsAR Locale = "es_AR"
+	LocaleEsES Locale = "es_ES"
+	LocaleEsMX Locale = "es_MX"
+	LocaleFrFR Locale = "fr_FR"
+	LocaleHuHU Locale = "hu_HU"
+	LocaleIDID Locale = "id_ID"
+	LocaleItIT Locale = "it_IT"
+	LocaleJaJP Locale = "ja_JP"
+	LocaleKoKR Locale = "ko_KR"
+	LocalePlPL Locale = "pl_PL"
+	LocalePtBR Locale = "pt_BR"
+	LocaleRoRO Locale = "ro_RO"
+	LocaleRuRU Locale = "ru_RU"
+	LocaleThTH Locale = "th_TH"
+	LocaleTrTR Locale = "tr_TR"
+	LocaleViVN Locale = "vi_VN"
+	LocaleZhCN Locale = "zh_CN"
+	LocaleZhMY Locale = "zh_MY"
+	LocaleZhTW Locale = "zh_TW"
+
+	// DefaultLocale is used when the loaders receive an empty locale.
+	DefaultLocale = LocaleEnUS
+)
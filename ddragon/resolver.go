@@ -0,0 +1,117 @@
+package ddragon
+
+import (
+	"cmp"
+	"context"
+	_ "embed"
+	"encoding/json"
+	"leago/options"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// ChampionResolver maps the numeric champion IDs used by the Riot API, like 62,
+// to the Data Dragon champion with its ID, name, title and icon.
+// It's read-only once built and safe for concurrent use.
+type ChampionResolver struct {
+	version string
+	baseURL string
+	byKey   map[int]Champion
+	byID    map[string]Champion
+}
+
+// SnapshotVersion is the Data Dragon version of the embedded champion snapshot.
+// The snapshot is the champion.json of the version, trimmed to the fields read by the resolver.
+const SnapshotVersion = "15.14.1"
+
+var (
+	//go:embed snapshot/champion.json
+	championSnapshot []byte
+
+	embeddedResolver = sync.OnceValue(func() *ChampionResolver {
+		var list List[Champion]
+		if err := json.Unmarshal(championSnapshot, &list); err != nil {
+			panic("ddragon: invalid embedded champion snapshot: " + err.Error())
+		}
+		return NewChampionResolver(list)
+	})
+)
+
+// NewChampionResolver returns a resolver for the champions of the list, like the one returned by GetChampions.
+// Icon URLs point to the DefaultBaseURL.
+func NewChampionResolver(champions List[Champion]) *ChampionResolver {
+	r := &ChampionResolver{
+		version: champions.Version,
+		baseURL: DefaultBaseURL,
+		byKey:   make(map[int]Champion, len(champions.Data)),
+		byID:    make(map[string]Champion, len(champions.Data)),
+	}
+
+	for _, champion := range champions.Data {
+		r.byKey[champion.Key] = champion
+		r.byID[strings.ToLower(champion.ID)] = champion
+	}
+
+	return r
+}
+
+// EmbeddedChampionResolver returns the resolver of the champion snapshot embedded on the package, see SnapshotVersion.
+// It needs no requests, but doesn't know the champions released after the snapshot, prefer Client.ChampionResolver
+// when the latest champions are needed.
+func EmbeddedChampionResolver() *ChampionResolver {
+	return embeddedResolver()
+}
+
+// ChampionResolver loads the champions of the version and locale and returns their resolver.
+// Icon URLs point to the client base URL.
+func (c *Client) ChampionResolver(
+	ctx context.Context,
+	version string,
+	locale Locale,
+	opts ...options.PublicOption,
+) (*ChampionResolver, error) {
+	champions, err := c.GetChampions(ctx, version, locale, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	r := NewChampionResolver(champions)
+	r.baseURL = c.baseURL
+	return r, nil
+}
+
+// Version returns the Data Dragon version of the champions.
+func (r *ChampionResolver) Version() string {
+	return r.version
+}
+
+// ByKey returns the champion got by the numeric champion ID used by the Riot API, like 62.
+func (r *ChampionResolver) ByKey(key int) (Champion, bool) {
+	champion, ok := r.byKey[key]
+	return champion, ok
+}
+
+// ByID returns the champion got by the Data Dragon champion ID, like "MonkeyKing", ignoring the case.
+func (r *ChampionResolver) ByID(id string) (Champion, bool) {
+	champion, ok := r.byID[strings.ToLower(id)]
+	return champion, ok
+}
+
+// Champions returns every champion sorted by name.
+func (r *ChampionResolver) Champions() []Champion {
+	out := make([]Champion, 0, len(r.byKey))
+	for _, champion := range r.byKey {
+		out = append(out, champion)
+	}
+
+	slices.SortFunc(out, func(a, b Champion) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+	return out
+}
+
+// IconURL returns the URL of the champion square icon on the resolver version.
+func (r *ChampionResolver) IconURL(champion Champion) string {
+	return versionedURL(r.baseURL, r.version, "img", "champion", champion.Image.Full)
+}
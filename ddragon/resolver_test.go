@@ -0,0 +1,95 @@
+package ddragon
+
+import (
+	"context"
+	"leago/internal/mock"
+	"log/slog"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmbeddedChampionResolver(t *testing.T) {
+	r := EmbeddedChampionResolver()
+	assert.Same(t, r, EmbeddedChampionResolver())
+	assert.Equal(t, SnapshotVersion, r.Version())
+
+	tests := []struct {
+		name          string
+		key           int
+		expectedID    string
+		expectedName  string
+		expectedTitle string
+	}{
+		{name: "first champion", key: 1, expectedID: "Annie", expectedName: "Annie", expectedTitle: "the Dark Child"},
+		{name: "id differs from name", key: 62, expectedID: "MonkeyKing", expectedName: "Wukong", expectedTitle: "the Monkey King"},
+		{name: "name with apostrophe", key: 145, expectedID: "Kaisa", expectedName: "Kai'Sa", expectedTitle: "Daughter of the Void"},
+		{name: "released on 14.3", key: 901, expectedID: "Smolder", expectedName: "Smolder", expectedTitle: "the Fiery Fledgling"},
+		{name: "released on 14.20", key: 799, expectedID: "Ambessa", expectedName: "Ambessa", expectedTitle: "Matriarch of War"},
+		{name: "released on 15.2", key: 800, expectedID: "Mel", expectedName: "Mel", expectedTitle: "the Soul's Reflection"},
+		{name: "latest on snapshot", key: 804, expectedID: "Yunara", expectedName: "Yunara", expectedTitle: "the Unbroken Faith"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			champion, ok := r.ByKey(tt.key)
+			require.True(t, ok)
+			assert.Equal(t, tt.expectedID, champion.ID)
+			assert.Equal(t, tt.expectedName, champion.Name)
+			assert.Equal(t, tt.expectedTitle, champion.Title)
+
+			byID, ok := r.ByID(tt.expectedID)
+			require.True(t, ok)
+			assert.Equal(t, champion, byID)
+		})
+	}
+
+	_, ok := r.ByKey(0)
+	assert.False(t, ok)
+
+	champions := r.Champions()
+	assert.Len(t, champions, 171)
+	assert.Equal(t, "Aatrox", champions[0].Name)
+	for _, champion := range champions {
+		assert.Equal(t, champion.ID+".png", champion.Image.Full)
+	}
+}
+
+func TestChampionResolverByID(t *testing.T) {
+	r := EmbeddedChampionResolver()
+
+	champion, ok := r.ByID("monkeyking")
+	require.True(t, ok)
+	assert.Equal(t, 62, champion.Key)
+
+	_, ok = r.ByID("Wukong")
+	assert.False(t, ok)
+}
+
+func TestClientChampionResolver(t *testing.T) {
+	mockDoer := mock.NewDefaultDoer(http.StatusOK, championsJSON, nil)
+	c := NewClient(mockDoer, slog.Default(), "http://localhost:8080")
+
+	r, err := c.ChampionResolver(context.Background(), "14.1.1", LocaleEnUS)
+	require.NoError(t, err)
+	assert.Equal(t, "/cdn/14.1.1/data/en_US/champion.json", mockDoer.CapturedReq.URL.Path)
+	assert.Equal(t, "14.1.1", r.Version())
+
+	champion, ok := r.ByKey(62)
+	require.True(t, ok)
+	assert.Equal(t, "Wukong", champion.Name)
+	assert.Equal(t, "http://localhost:8080/cdn/14.1.1/img/champion/MonkeyKing.png", r.IconURL(champion))
+
+	_, err = NewClient(mock.NewDefaultDoer(http.StatusForbidden, ``, nil), slog.Default(), "").ChampionResolver(context.Background(), "14.1.1", LocaleEnUS)
+	assert.Error(t, err)
+}
+
+func TestEmbeddedChampionResolverIconURL(t *testing.T) {
+	r := EmbeddedChampionResolver()
+
+	champion, ok := r.ByKey(266)
+	require.True(t, ok)
+	assert.Equal(t, "https://ddragon.leagueoflegends.com/cdn/15.14.1/img/champion/Aatrox.png", r.IconURL(champion))
+}
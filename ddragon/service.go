@@ -0,0 +1,218 @@
+package ddragon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"leago/internal"
+	"leago/options"
+	"net/url"
+)
+
+const (
+	MethodGetVersions       = "DataDragon.GetVersions"
+	MethodGetRealm          = "DataDragon.GetRealm"
+	MethodGetChampions      = "DataDragon.GetChampions"
+	MethodGetChampion       = "DataDragon.GetChampion"
+	MethodGetItems          = "DataDragon.GetItems"
+	MethodGetRunesReforged  = "DataDragon.GetRunesReforged"
+	MethodGetSummonerSpells = "DataDragon.GetSummonerSpells"
+	MethodGetProfileIcons   = "DataDragon.GetProfileIcons"
+	MethodGetMaps           = "DataDragon.GetMaps"
+)
+
+var (
+	ErrNoVersions       = errors.New("ddragon: no versions available")
+	ErrChampionNotFound = errors.New("ddragon: champion not found")
+)
+
+// GetVersions returns every Data Dragon version, the latest first.
+func (c *Client) GetVersions(
+	ctx context.Context,
+	opts ...options.PublicOption,
+) ([]string, error) {
+	endpoint := "/api/versions.json"
+
+	defaultOpts := []internal.RequestOption{
+		internal.WithApiMethod(MethodGetVersions),
+	}
+
+	uri := c.client.GetURL(endpoint)
+	return internal.Request[[]string](
+		ctx,
+		c.client,
+		uri,
+		options.MergeOptions(defaultOpts, opts)...,
+	)
+}
+
+// GetLatestVersion returns the latest Data Dragon version.
+// Realms may lag behind the latest version for a few hours after a patch, see GetRealm.
+func (c *Client) GetLatestVersion(
+	ctx context.Context,
+	opts ...options.PublicOption,
+) (string, error) {
+	versions, err := c.GetVersions(ctx, opts...)
+	if err != nil {
+		return "", err
+	}
+
+	if len(versions) == 0 {
+		return "", ErrNoVersions
+	}
+	return versions[0], nil
+}
+
+// GetRealm returns the versions served on the realm, like "na", "euw" or "kr".
+func (c *Client) GetRealm(
+	ctx context.Context,
+	realm string,
+	opts ...options.PublicOption,
+) (Realm, error) {
+	endpoint := fmt.Sprintf(
+		"/realms/%s.json",
+		url.PathEscape(realm),
+	)
+
+	defaultOpts := []internal.RequestOption{
+		internal.WithApiMethod(MethodGetRealm),
+	}
+
+	uri := c.client.GetURL(endpoint)
+	return internal.Request[Realm](
+		ctx,
+		c.client,
+		uri,
+		options.MergeOptions(defaultOpts, opts)...,
+	)
+}
+
+// GetChampions returns the summary of every champion, keyed by the champion ID like "MonkeyKing".
+func (c *Client) GetChampions(
+	ctx context.Context,
+	version string,
+	locale Locale,
+	opts ...options.PublicOption,
+) (List[Champion], error) {
+	return getData[List[Champion]](ctx, c, MethodGetChampions, version, locale, "champion.json", opts)
+}
+
+// GetChampion returns the full data of the champion got by the champion ID, like "MonkeyKing".
+func (c *Client) GetChampion(
+	ctx context.Context,
+	version string,
+	locale Locale,
+	championID string,
+	opts ...options.PublicOption,
+) (ChampionDetail, error) {
+	file := fmt.Sprintf("champion/%s.json", url.PathEscape(championID))
+
+	list, err := getData[List[ChampionDetail]](ctx, c, MethodGetChampion, version, locale, file, opts)
+	if err != nil {
+		return ChampionDetail{}, err
+	}
+
+	champion, ok := list.Data[championID]
+	if !ok {
+		return ChampionDetail{}, fmt.Errorf("%w: %q", ErrChampionNotFound, championID)
+	}
+	return champion, nil
+}
+
+// GetItems returns every item keyed by the item ID, with the shop groups and tree.
+func (c *Client) GetItems(
+	ctx context.Context,
+	version string,
+	locale Locale,
+	opts ...options.PublicOption,
+) (ItemList, error) {
+	return getData[ItemList](ctx, c, MethodGetItems, version, locale, "item.json", opts)
+}
+
+// GetRunesReforged returns the rune paths with their slots and runes.
+func (c *Client) GetRunesReforged(
+	ctx context.Context,
+	version string,
+	locale Locale,
+	opts ...options.PublicOption,
+) ([]RunePath, error) {
+	return getData[[]RunePath](ctx, c, MethodGetRunesReforged, version, locale, "runesReforged.json", opts)
+}
+
+// GetSummonerSpells returns every summoner spell keyed by the spell ID, like "SummonerFlash".
+func (c *Client) GetSummonerSpells(
+	ctx context.Context,
+	version string,
+	locale Locale,
+	opts ...options.PublicOption,
+) (List[SummonerSpell], error) {
+	return getData[List[SummonerSpell]](ctx, c, MethodGetSummonerSpells, version, locale, "summoner.json", opts)
+}
+
+// GetProfileIcons returns every profile icon keyed by the icon ID.
+func (c *Client) GetProfileIcons(
+	ctx context.Context,
+	version string,
+	locale Locale,
+	opts ...options.PublicOption,
+) (List[ProfileIcon], error) {
+	return getData[List[ProfileIcon]](ctx, c, MethodGetProfileIcons, version, locale, "profileicon.json", opts)
+}
+
+// GetMaps returns every map keyed by the map ID.
+func (c *Client) GetMaps(
+	ctx context.Context,
+	version string,
+	locale Locale,
+	opts ...options.PublicOption,
+) (List[Map], error) {
+	return getData[List[Map]](ctx, c, MethodGetMaps, version, locale, "map.json", opts)
+}
+
+// resolveVersion returns the latest version for VersionLatest and empty versions.
+func (c *Client) resolveVersion(ctx context.Context, version string) (string, error) {
+	if version != "" && version != VersionLatest {
+		return version, nil
+	}
+	return c.GetLatestVersion(ctx)
+}
+
+// getData loads a data file of the version and locale, like champion.json.
+func getData[T any](
+	ctx context.Context,
+	c *Client,
+	method,
+	version string,
+	locale Locale,
+	file string,
+	opts []options.PublicOption,
+) (T, error) {
+	version, err := c.resolveVersion(ctx, version)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+
+	if locale == "" {
+		locale = DefaultLocale
+	}
+
+	endpoint := fmt.Sprintf(
+		"/cdn/%s/data/%s/%s",
+		url.PathEscape(version),
+		url.PathEscape(string(locale)),
+		file,
+	)
+
+	defaultOpts := []internal.RequestOption{
+		internal.WithApiMethod(method),
+	}
+
+	uri := c.client.GetURL(endpoint)
+	return internal.Request[T](
+		ctx,
+		c.client,
+		uri,
+		options.MergeOptions(defaultOpts, opts)...,
+	)
+}
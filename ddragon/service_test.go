@@ -0,0 +1,268 @@
+package ddragon
+
+import (
+	"context"
+	"leago/internal"
+	"leago/internal/mock"
+	"log/slog"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const versionsJSON = `["14.1.1","14.1.0","13.24.1"]`
+
+func TestGetVersions(t *testing.T) {
+	tests := []struct {
+		name           string
+		statusCode     int
+		responseBody   string
+		expectedResult []string
+		wantErr        bool
+		wantRiotErr    bool
+	}{
+		{
+			name:         "cdn error",
+			statusCode:   http.StatusForbidden,
+			responseBody: `<Error><Code>AccessDenied</Code></Error>`,
+			wantErr:      true,
+			wantRiotErr:  true,
+		},
+		{
+			name:         "unmatched json",
+			statusCode:   http.StatusOK,
+			responseBody: `{"versions":[]}`,
+			wantErr:      true,
+			wantRiotErr:  false,
+		},
+		{
+			name:           "success",
+			statusCode:     http.StatusOK,
+			responseBody:   versionsJSON,
+			expectedResult: []string{"14.1.1", "14.1.0", "13.24.1"},
+			wantErr:        false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDoer := mock.NewDefaultDoer(tt.statusCode, tt.responseBody, nil)
+			c := NewClient(mockDoer, slog.Default(), "")
+			resp, err := c.GetVersions(context.Background())
+
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				if tt.wantRiotErr {
+					var rErr *internal.RiotError
+					assert.ErrorAs(t, err, &rErr)
+					assert.Equal(t, tt.statusCode, rErr.StatusCode)
+				}
+				return
+			}
+
+			require.Nil(t, err)
+			assert.Equal(t, "https://ddragon.leagueoflegends.com/api/versions.json", mockDoer.CapturedReq.URL.String())
+			assert.Empty(t, mockDoer.CapturedReq.Header.Get("X-Riot-Token"))
+			assert.Equal(t, tt.expectedResult, resp)
+		})
+	}
+}
+
+func TestGetLatestVersion(t *testing.T) {
+	version, err := NewClient(mock.NewDefaultDoer(http.StatusOK, versionsJSON, nil), slog.Default(), "").GetLatestVersion(context.Background())
+	require.Nil(t, err)
+	assert.Equal(t, "14.1.1", version)
+
+	_, err = NewClient(mock.NewDefaultDoer(http.StatusOK, `[]`, nil), slog.Default(), "").GetLatestVersion(context.Background())
+	assert.ErrorIs(t, err, ErrNoVersions)
+}
+
+func TestGetRealm(t *testing.T) {
+	mockDoer := mock.NewDefaultDoer(http.StatusOK, `{"n":{"champion":"14.1.1","item":"14.1.1"},"v":"14.1.1","l":"en_US","cdn":"https://ddragon.leagueoflegends.com/cdn","dd":"14.1.1","lg":"14.1.1","css":"14.1.1","profileiconmax":28}`, nil)
+	c := NewClient(mockDoer, slog.Default(), "http://localhost:8080/ddragon/")
+
+	realm, err := c.GetRealm(context.Background(), "na")
+	require.Nil(t, err)
+	assert.Equal(t, "http://localhost:8080/ddragon/realms/na.json", mockDoer.CapturedReq.URL.String())
+	assert.Equal(t, Realm{
+		Version:           "14.1.1",
+		Locale:            LocaleEnUS,
+		CDN:               "https://ddragon.leagueoflegends.com/cdn",
+		DataDragonVersion: "14.1.1",
+		LegacyVersion:     "14.1.1",
+		CSSVersion:        "14.1.1",
+		ProfileIconMax:    28,
+		Versions:          map[string]string{"champion": "14.1.1", "item": "14.1.1"},
+	}, realm)
+}
+
+func TestGetChampions(t *testing.T) {
+	tests := []struct {
+		name          string
+		version       string
+		locale        Locale
+		responses     []string
+		expectedPaths []string
+	}{
+		{
+			name:          "version and locale",
+			version:       "13.24.1",
+			locale:        LocalePtBR,
+			responses:     []string{championsJSON},
+			expectedPaths: []string{"/cdn/13.24.1/data/pt_BR/champion.json"},
+		},
+		{
+			name:          "latest version and default locale",
+			version:       VersionLatest,
+			responses:     []string{versionsJSON, championsJSON},
+			expectedPaths: []string{"/api/versions.json", "/cdn/14.1.1/data/en_US/champion.json"},
+		},
+		{
+			name:          "empty version",
+			locale:        LocaleKoKR,
+			responses:     []string{versionsJSON, championsJSON},
+			expectedPaths: []string{"/api/versions.json", "/cdn/14.1.1/data/ko_KR/champion.json"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doer := &mock.SequenceDoer{}
+			for _, body := range tt.responses {
+				doer.Responses = append(doer.Responses, mock.NewResponse(http.StatusOK, body))
+			}
+			c := NewClient(doer, slog.Default(), "")
+
+			champions, err := c.GetChampions(context.Background(), tt.version, tt.locale)
+			require.Nil(t, err)
+
+			paths := make([]string, len(doer.CapturedReqs))
+			for i, req := range doer.CapturedReqs {
+				paths[i] = req.URL.Path
+			}
+			assert.Equal(t, tt.expectedPaths, paths)
+
+			assert.Equal(t, "14.1.1", champions.Version)
+			assert.Equal(t, Champion{
+				Version: "14.1.1",
+				ID:      "MonkeyKing",
+				Key:     62,
+				Name:    "Wukong",
+				Title:   "the Monkey King",
+				Info:    ChampionInfo{Attack: 8, Defense: 5, Magic: 2, Difficulty: 3},
+				Image:   Image{Full: "MonkeyKing.png", Sprite: "champion2.png", Group: "champion", X: 48, W: 48, H: 48},
+				Tags:    []string{"Fighter", "Tank"},
+				Partype: "Mana",
+				Stats:   ChampionStats{HP: 610, HPPerLevel: 99, AttackSpeed: 0.69},
+			}, champions.Data["MonkeyKing"])
+		})
+	}
+}
+
+func TestGetChampion(t *testing.T) {
+	body := `{"type":"champion","version":"14.1.1","data":{"MonkeyKing":{"id":"MonkeyKing","key":"62","name":"Wukong","skins":[{"id":"62000","num":0,"name":"default","chromas":false}],"lore":"lore","spells":[{"id":"MonkeyKingDoubleAttack","name":"Crushing Blow","maxrank":5,"cooldown":[9,8.5,8,7.5,7]}],"passive":{"name":"Stone Skin","image":{"full":"MonkeyKingStoneSkin.png","group":"passive"}}}}}`
+	mockDoer := mock.NewDefaultDoer(http.StatusOK, body, nil)
+	c := NewClient(mockDoer, slog.Default(), "")
+
+	champion, err := c.GetChampion(context.Background(), "14.1.1", LocaleEnUS, "MonkeyKing")
+	require.Nil(t, err)
+	assert.Equal(t, "/cdn/14.1.1/data/en_US/champion/MonkeyKing.json", mockDoer.CapturedReq.URL.Path)
+	assert.Equal(t, 62, champion.Key)
+	assert.Equal(t, "Wukong", champion.Name)
+	assert.Equal(t, []Skin{{ID: "62000", Num: 0, Name: "default"}}, champion.Skins)
+	assert.Equal(t, []float64{9, 8.5, 8, 7.5, 7}, champion.Spells[0].Cooldown)
+	assert.Equal(t, "passive", champion.Passive.Image.Group)
+
+	c = NewClient(mock.NewDefaultDoer(http.StatusOK, body, nil), slog.Default(), "")
+	_, err = c.GetChampion(context.Background(), "14.1.1", LocaleEnUS, "Wukong")
+	assert.ErrorIs(t, err, ErrChampionNotFound)
+}
+
+func TestGetItems(t *testing.T) {
+	mockDoer := mock.NewDefaultDoer(http.StatusOK, `{"type":"item","version":"14.1.1","data":{"1001":{"name":"Boots","into":["3006"],"image":{"full":"1001.png","group":"item"},"gold":{"base":300,"purchasable":true,"total":300,"sell":210},"tags":["Boots"],"maps":{"11":true,"12":true},"stats":{"FlatMovementSpeedMod":25}}},"groups":[{"id":"BootsNormal","MaxGroupOwnable":"1"}],"tree":[{"header":"START","tags":["LANE"]}]}`, nil)
+	c := NewClient(mockDoer, slog.Default(), "")
+
+	items, err := c.GetItems(context.Background(), "14.1.1", LocaleEnUS)
+	require.Nil(t, err)
+	assert.Equal(t, "/cdn/14.1.1/data/en_US/item.json", mockDoer.CapturedReq.URL.Path)
+	assert.Equal(t, "14.1.1", items.Version)
+	assert.Equal(t, Item{
+		Name:  "Boots",
+		Into:  []string{"3006"},
+		Image: Image{Full: "1001.png", Group: "item"},
+		Gold:  ItemGold{Base: 300, Purchasable: true, Total: 300, Sell: 210},
+		Tags:  []string{"Boots"},
+		Maps:  map[string]bool{"11": true, "12": true},
+		Stats: map[string]float64{"FlatMovementSpeedMod": 25},
+	}, items.Data["1001"])
+	assert.Equal(t, []ItemGroup{{ID: "BootsNormal", MaxGroupOwnable: "1"}}, items.Groups)
+	assert.Equal(t, []ItemTree{{Header: "START", Tags: []string{"LANE"}}}, items.Tree)
+}
+
+func TestGetRunesReforged(t *testing.T) {
+	mockDoer := mock.NewDefaultDoer(http.StatusOK, `[{"id":8000,"key":"Precision","icon":"perk-images/Styles/7201_Precision.png","name":"Precision","slots":[{"runes":[{"id":8005,"key":"PressTheAttack","icon":"perk-images/Styles/Precision/PressTheAttack/PressTheAttack.png","name":"Press the Attack","shortDesc":"short","longDesc":"long"}]}]}]`, nil)
+	c := NewClient(mockDoer, slog.Default(), "")
+
+	paths, err := c.GetRunesReforged(context.Background(), "14.1.1", LocaleEnUS)
+	require.Nil(t, err)
+	assert.Equal(t, "/cdn/14.1.1/data/en_US/runesReforged.json", mockDoer.CapturedReq.URL.Path)
+	assert.Equal(t, []RunePath{{
+		ID:   8000,
+		Key:  "Precision",
+		Icon: "perk-images/Styles/7201_Precision.png",
+		Name: "Precision",
+		Slots: []RuneSlot{{Runes: []Rune{{
+			ID:        8005,
+			Key:       "PressTheAttack",
+			Icon:      "perk-images/Styles/Precision/PressTheAttack/PressTheAttack.png",
+			Name:      "Press the Attack",
+			ShortDesc: "short",
+			LongDesc:  "long",
+		}}}},
+	}}, paths)
+}
+
+func TestGetSummonerSpells(t *testing.T) {
+	mockDoer := mock.NewDefaultDoer(http.StatusOK, `{"type":"summoner","version":"14.1.1","data":{"SummonerFlash":{"id":"SummonerFlash","name":"Flash","maxrank":1,"cooldown":[300],"cooldownBurn":"300","key":"4","summonerLevel":7,"modes":["CLASSIC"],"range":[400],"image":{"full":"SummonerFlash.png","group":"spell"}}}}`, nil)
+	c := NewClient(mockDoer, slog.Default(), "")
+
+	spells, err := c.GetSummonerSpells(context.Background(), "14.1.1", LocaleEnUS)
+	require.Nil(t, err)
+	assert.Equal(t, "/cdn/14.1.1/data/en_US/summoner.json", mockDoer.CapturedReq.URL.Path)
+	assert.Equal(t, SummonerSpell{
+		ID:            "SummonerFlash",
+		Name:          "Flash",
+		MaxRank:       1,
+		Cooldown:      []float64{300},
+		CooldownBurn:  "300",
+		Key:           4,
+		SummonerLevel: 7,
+		Modes:         []string{"CLASSIC"},
+		Range:         []float64{400},
+		Image:         Image{Full: "SummonerFlash.png", Group: "spell"},
+	}, spells.Data["SummonerFlash"])
+}
+
+func TestGetProfileIcons(t *testing.T) {
+	mockDoer := mock.NewDefaultDoer(http.StatusOK, `{"type":"profileicon","version":"14.1.1","data":{"29":{"id":29,"image":{"full":"29.png","group":"profileicon"}}}}`, nil)
+	c := NewClient(mockDoer, slog.Default(), "")
+
+	icons, err := c.GetProfileIcons(context.Background(), "14.1.1", LocaleEnUS)
+	require.Nil(t, err)
+	assert.Equal(t, "/cdn/14.1.1/data/en_US/profileicon.json", mockDoer.CapturedReq.URL.Path)
+	assert.Equal(t, ProfileIcon{ID: 29, Image: Image{Full: "29.png", Group: "profileicon"}}, icons.Data["29"])
+}
+
+func TestGetMaps(t *testing.T) {
+	mockDoer := mock.NewDefaultDoer(http.StatusOK, `{"type":"map","version":"14.1.1","data":{"11":{"MapName":"Summoner's Rift","MapId":"11","image":{"full":"map11.png","group":"map"}}}}`, nil)
+	c := NewClient(mockDoer, slog.Default(), "")
+
+	maps, err := c.GetMaps(context.Background(), "14.1.1", LocaleEnUS)
+	require.Nil(t, err)
+	assert.Equal(t, "/cdn/14.1.1/data/en_US/map.json", mockDoer.CapturedReq.URL.Path)
+	assert.Equal(t, Map{MapName: "Summoner's Rift", MapID: "11", Image: Image{Full: "map11.png", Group: "map"}}, maps.Data["11"])
+}
+
+const championsJSON = `{"type":"champion","format":"standAloneComplex","version":"14.1.1","data":{"MonkeyKing":{"version":"14.1.1","id":"MonkeyKing","key":"62","name":"Wukong","title":"the Monkey King","info":{"attack":8,"defense":5,"magic":2,"difficulty":3},"image":{"full":"MonkeyKing.png","sprite":"champion2.png","group":"champion","x":48,"y":0,"w":48,"h":48},"tags":["Fighter","Tank"],"partype":"Mana","stats":{"hp":610,"hpperlevel":99,"attackspeed":0.69}}}}`
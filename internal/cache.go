@@ -0,0 +1,44 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"leago/cache"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// cacheKey returns the key used to store a response, the route is kept since base URLs can be overridden.
+func cacheKey(route string, u *url.URL) string {
+	return route + " " + u.String()
+}
+
+// cacheTTL returns how long the response of the request should be cached, zero if it shouldn't be.
+// Only GET requests are cached, requests with a bearer token are player specific and never cached.
+func (c *Client) cacheTTL(ro *requestOptions) time.Duration {
+	if c.cache == nil || ro.bearerToken != "" || (ro.httpMethod != "" && ro.httpMethod != http.MethodGet) {
+		return 0
+	}
+
+	if ro.cacheTTL != nil {
+		return *ro.cacheTTL
+	}
+
+	return c.cacheTTLs[ro.apiMethod]
+}
+
+// canServeStale returns if an expired entry can be returned instead of the error.
+// Only outages (transport errors, 429 and 5xx) qualify, a 404 must not be hidden by an old response.
+func (c *Client) canServeStale(ctx context.Context, err error, entry cache.Entry) bool {
+	if c.staleIfError <= 0 || ctx.Err() != nil {
+		return false
+	}
+
+	var rErr *RiotError
+	if errors.As(err, &rErr) && rErr.StatusCode < 500 && rErr.StatusCode != http.StatusTooManyRequests {
+		return false
+	}
+
+	return time.Now().Before(entry.Expires.Add(c.staleIfError))
+}
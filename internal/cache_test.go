@@ -0,0 +1,192 @@
+package internal
+
+import (
+	"context"
+	"leago/cache"
+	"leago/internal/mock"
+	"log/slog"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// expiringCache allows forcing every entry to be expired.
+type expiringCache struct {
+	*cache.LRU
+	expire bool
+}
+
+func (c *expiringCache) Get(key string) (cache.Entry, bool) {
+	entry, ok := c.LRU.Get(key)
+	if c.expire {
+		entry.Expires = time.Now().Add(-time.Second)
+	}
+	return entry, ok
+}
+
+func TestRequestCache(t *testing.T) {
+	const method = "Test.Cached"
+
+	tests := []struct {
+		name         string
+		responses    []*http.Response
+		ttls         map[string]time.Duration
+		staleIfError time.Duration
+		expireFirst  bool
+		secondOpts   []RequestOption
+		wantCalls    int
+		wantName     string
+		wantErr      bool
+		wantCached   bool
+	}{
+		{
+			name: "second request served from cache",
+			responses: []*http.Response{
+				mock.NewResponse(http.StatusOK, `{"name":"first"}`),
+				mock.NewResponse(http.StatusOK, `{"name":"second"}`),
+			},
+			ttls:       map[string]time.Duration{method: time.Minute},
+			wantCalls:  1,
+			wantName:   "first",
+			wantCached: true,
+		},
+		{
+			name: "method without ttl is not cached",
+			responses: []*http.Response{
+				mock.NewResponse(http.StatusOK, `{"name":"first"}`),
+				mock.NewResponse(http.StatusOK, `{"name":"second"}`),
+			},
+			ttls:      map[string]time.Duration{},
+			wantCalls: 2,
+			wantName:  "second",
+		},
+		{
+			name: "request ttl enables cache",
+			responses: []*http.Response{
+				mock.NewResponse(http.StatusOK, `{"name":"first"}`),
+				mock.NewResponse(http.StatusOK, `{"name":"second"}`),
+			},
+			ttls:       map[string]time.Duration{},
+			secondOpts: []RequestOption{WithCacheTTL(time.Minute)},
+			wantCalls:  2,
+			wantName:   "second",
+		},
+		{
+			name: "bypass refreshes the entry",
+			responses: []*http.Response{
+				mock.NewResponse(http.StatusOK, `{"name":"first"}`),
+				mock.NewResponse(http.StatusOK, `{"name":"second"}`),
+			},
+			ttls:       map[string]time.Duration{method: time.Minute},
+			secondOpts: []RequestOption{WithCacheBypass()},
+			wantCalls:  2,
+			wantName:   "second",
+		},
+		{
+			name: "post is not cached",
+			responses: []*http.Response{
+				mock.NewResponse(http.StatusOK, `{"name":"first"}`),
+				mock.NewResponse(http.StatusOK, `{"name":"second"}`),
+			},
+			ttls:       map[string]time.Duration{method: time.Minute},
+			secondOpts: []RequestOption{WithHttpMethod(http.MethodPost)},
+			wantCalls:  2,
+			wantName:   "second",
+		},
+		{
+			name: "bearer token is not cached",
+			responses: []*http.Response{
+				mock.NewResponse(http.StatusOK, `{"name":"first"}`),
+				mock.NewResponse(http.StatusOK, `{"name":"second"}`),
+			},
+			ttls:       map[string]time.Duration{method: time.Minute},
+			secondOpts: []RequestOption{WithBearerToken("accessToken"), WithCacheTTL(time.Minute)},
+			wantCalls:  2,
+			wantName:   "second",
+		},
+		{
+			name: "expired entry is refreshed",
+			responses: []*http.Response{
+				mock.NewResponse(http.StatusOK, `{"name":"first"}`),
+				mock.NewResponse(http.StatusOK, `{"name":"second"}`),
+			},
+			ttls:        map[string]time.Duration{method: time.Minute},
+			expireFirst: true,
+			wantCalls:   2,
+			wantName:    "second",
+		},
+		{
+			name: "stale served on outage",
+			responses: []*http.Response{
+				mock.NewResponse(http.StatusOK, `{"name":"first"}`),
+				mock.NewResponse(http.StatusServiceUnavailable, ""),
+			},
+			ttls:         map[string]time.Duration{method: time.Minute},
+			staleIfError: time.Hour,
+			expireFirst:  true,
+			wantCalls:    2,
+			wantName:     "first",
+			wantCached:   true,
+		},
+		{
+			name: "stale not served without stale if error",
+			responses: []*http.Response{
+				mock.NewResponse(http.StatusOK, `{"name":"first"}`),
+				mock.NewResponse(http.StatusServiceUnavailable, ""),
+			},
+			ttls:        map[string]time.Duration{method: time.Minute},
+			expireFirst: true,
+			wantCalls:   2,
+			wantErr:     true,
+		},
+		{
+			name: "stale not served on not found",
+			responses: []*http.Response{
+				mock.NewResponse(http.StatusOK, `{"name":"first"}`),
+				mock.NewResponse(http.StatusNotFound, ""),
+			},
+			ttls:         map[string]time.Duration{method: time.Minute},
+			staleIfError: time.Hour,
+			expireFirst:  true,
+			wantCalls:    2,
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doer := &mock.SequenceDoer{Responses: tt.responses}
+			store := &expiringCache{LRU: cache.NewLRU(10)}
+			client := NewHttpClient(
+				doer,
+				slog.Default(),
+				"test",
+				"apiKey",
+				WithCache(store, tt.ttls, tt.staleIfError),
+				WithDefaultRetryPolicy(NoRetry()),
+			)
+
+			_, err := AuthRequest[Response](context.Background(), client, "http://testexample.com", WithApiMethod(method))
+			require.NoError(t, err)
+
+			store.expire = tt.expireFirst
+
+			var meta ResponseMeta
+			opts := append([]RequestOption{WithApiMethod(method), WithResponseMeta(&meta)}, tt.secondOpts...)
+			got, err := AuthRequest[Response](context.Background(), client, "http://testexample.com", opts...)
+
+			assert.Len(t, doer.CapturedReqs, tt.wantCalls)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantName, got.Name)
+			assert.Equal(t, tt.wantCached, meta.FromCache)
+		})
+	}
+}
@@ -2,31 +2,112 @@ package internal
 
 import (
 	"fmt"
+	"leago/cache"
+	"leago/regions"
 	"log/slog"
+	"strings"
+	"time"
 )
 
-const (
-	apiURLFormat = "https://%s.api.riotgames.com%s"
+type (
+	Client struct {
+		Http        Doer
+		Logger      *slog.Logger
+		Limiter     *RateLimiter
+		retryPolicy RetryPolicy
+		routePrefix string
+		product     regions.Product
+		apiKey      string
+		baseURL     func(route string) string
+
+		cache        cache.Cache
+		cacheTTLs    map[string]time.Duration
+		staleIfError time.Duration
+
+		coalescer    *coalescer
+		interceptors []Interceptor
+		keys         KeyProvider
+	}
+
+	// ClientOption configures the behavior shared by every request of a client.
+	ClientOption func(*Client)
 )
 
-type Client struct {
-	Http        Doer
-	Logger      *slog.Logger
-	routePrefix string
-	apiKey      string
-}
+const (
+	apiBaseURLFormat = "https://%s.api.riotgames.com"
+)
 
-func NewHttpClient(client Doer, logger *slog.Logger, route, apiKey string) *Client {
+func NewHttpClient(client Doer, logger *slog.Logger, route, apiKey string, opts ...ClientOption) *Client {
 	c := &Client{
 		Http:        client,
 		Logger:      logger,
+		Limiter:     NewRateLimiter(),
+		retryPolicy: DefaultRetryPolicy(),
 		routePrefix: route,
 		apiKey:      apiKey,
+		baseURL:     DefaultBaseURL,
+	}
+
+	for _, opt := range opts {
+		opt(c)
 	}
 
 	return c
 }
 
 func (c *Client) GetURL(endpoint string) string {
-	return fmt.Sprintf(apiURLFormat, c.routePrefix, endpoint)
+	return strings.TrimSuffix(c.baseURL(c.routePrefix), "/") + endpoint
+}
+
+// DefaultBaseURL returns the Riot API host for the route, like https://br1.api.riotgames.com.
+func DefaultBaseURL(route string) string {
+	return fmt.Sprintf(apiBaseURLFormat, route)
+}
+
+// WithBaseURL overrides how the base URL is built from the route, used for proxies and local servers.
+func WithBaseURL(baseURL func(route string) string) ClientOption {
+	return func(c *Client) {
+		if baseURL != nil {
+			c.baseURL = baseURL
+		}
+	}
+}
+
+// WithRateLimiter sets the rate limiter, allowing it to be shared between clients.
+func WithRateLimiter(limiter *RateLimiter) ClientOption {
+	return func(c *Client) {
+		c.Limiter = limiter
+	}
+}
+
+// WithDefaultRetryPolicy sets the retry policy used when the request doesn't override it.
+func WithDefaultRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *Client) {
+		c.retryPolicy = policy
+	}
+}
+
+// WithCache enables the response cache, with the TTLs keyed by apiMethod.
+// Methods without a TTL are only cached when the request sets one.
+// Expired entries are served for up to staleIfError when Riot is failing, zero disables it.
+func WithCache(c cache.Cache, ttls map[string]time.Duration, staleIfError time.Duration) ClientOption {
+	return func(cl *Client) {
+		cl.cache = c
+		cl.cacheTTLs = ttls
+		cl.staleIfError = staleIfError
+	}
+}
+
+// WithProduct sets the game served by the client, passed to the key provider to pick a key registered for it.
+func WithProduct(product regions.Product) ClientOption {
+	return func(c *Client) {
+		c.product = product
+	}
+}
+
+// WithCoalescing enables sharing a single upstream call between concurrent identical GET requests.
+func WithCoalescing() ClientOption {
+	return func(c *Client) {
+		c.coalescer = newCoalescer()
+	}
 }
@@ -21,3 +21,20 @@ func TestGetURL(t *testing.T) {
 	url := client.GetURL("/testapi")
 	assert.Contains(t, url, string(regions.PlatformBR1))
 }
+
+func TestGetURLWithBaseURL(t *testing.T) {
+	client := NewHttpClient(
+		http.DefaultClient,
+		slog.Default(),
+		string(regions.PlatformBR1),
+		"apiKey",
+		WithBaseURL(func(route string) string { return "http://localhost:8080/" + route + "/" }),
+	)
+
+	assert.Equal(t, "http://localhost:8080/br1/testapi", client.GetURL("/testapi"))
+	assert.Equal(t, "https://br1.api.riotgames.com/testapi", NewHttpClient(http.DefaultClient, slog.Default(), "br1", "").GetURL("/testapi"))
+
+	// Nil keeps the default.
+	nilBase := NewHttpClient(http.DefaultClient, slog.Default(), "br1", "", WithBaseURL(nil))
+	assert.Equal(t, "https://br1.api.riotgames.com/testapi", nilBase.GetURL("/testapi"))
+}
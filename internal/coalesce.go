@@ -0,0 +1,158 @@
+package internal
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+type (
+	// coalescer deduplicates identical in-flight requests, sharing a single upstream call between the callers.
+	coalescer struct {
+		mu    sync.Mutex
+		calls map[string]*call
+	}
+
+	// call is an in-flight request shared by its waiters.
+	call struct {
+		done    chan struct{}
+		val     any
+		err     error
+		waiters int
+		cancel  context.CancelFunc
+	}
+)
+
+func newCoalescer() *coalescer {
+	return &coalescer{calls: make(map[string]*call)}
+}
+
+// do runs fn once for concurrent callers with the same key, returning the shared result.
+// fn runs on a context detached from the callers cancellation, each caller returns early when its own context is done.
+// The shared call keeps the deadline of the caller that started it, so its retries respect it,
+// and is cancelled earlier when every caller gave up.
+func (c *coalescer) do(ctx context.Context, key string, fn func(context.Context) (any, error)) (val any, shared bool, err error) {
+	c.mu.Lock()
+	cl, ok := c.calls[key]
+	if ok {
+		cl.waiters++
+		c.mu.Unlock()
+		return c.wait(ctx, key, cl, true)
+	}
+
+	callCtx, cancel := detach(ctx)
+	cl = &call{
+		done:    make(chan struct{}),
+		waiters: 1,
+		cancel:  cancel,
+	}
+	c.calls[key] = cl
+	c.mu.Unlock()
+
+	go func() {
+		defer cancel()
+		cl.val, cl.err = fn(callCtx)
+
+		c.mu.Lock()
+		if c.calls[key] == cl {
+			delete(c.calls, key)
+		}
+		c.mu.Unlock()
+
+		close(cl.done)
+	}()
+
+	return c.wait(ctx, key, cl, false)
+}
+
+// detach returns a context that isn't cancelled with ctx but keeps its deadline and values.
+func detach(ctx context.Context) (context.Context, context.CancelFunc) {
+	detached := context.WithoutCancel(ctx)
+	if deadline, ok := ctx.Deadline(); ok {
+		return context.WithDeadline(detached, deadline)
+	}
+	return context.WithCancel(detached)
+}
+
+// wait blocks until the call is done or the caller context is cancelled.
+func (c *coalescer) wait(ctx context.Context, key string, cl *call, shared bool) (any, bool, error) {
+	select {
+	case <-cl.done:
+		return cl.val, shared, cl.err
+	case <-ctx.Done():
+		c.mu.Lock()
+		cl.waiters--
+		if cl.waiters == 0 {
+			// Nobody is waiting anymore, new callers must start a fresh call.
+			if c.calls[key] == cl {
+				delete(c.calls, key)
+			}
+			cl.cancel()
+		}
+		c.mu.Unlock()
+		return nil, shared, ctx.Err()
+	}
+}
+
+// coalesceKey identifies identical requests, fixed API keys and bearer tokens are included so they never share responses.
+// Keys of the client are only resolved once the shared call is sent, so identical requests share it whatever key it uses.
+// The cache options are included too, a request bypassing the cache must not get the result of one reading it.
+func coalesceKey(route string, u *url.URL, ro *requestOptions) string {
+	ttl := ""
+	if ro.cacheTTL != nil {
+		ttl = ro.cacheTTL.String()
+	}
+
+	return strings.Join([]string{
+		route,
+		ro.apiMethod,
+		u.String(),
+		ro.apiKey,
+		ro.bearerToken,
+		strconv.FormatBool(ro.cacheBypass),
+		ttl,
+	}, "\x00")
+}
+
+// coalesce runs the request through the coalescer, sharing the decoded result and response meta.
+func coalesce[T any](ctx context.Context, client *Client, uri string, ro *requestOptions) (T, error) {
+	var zero T
+
+	u, err := buildURL(uri, ro)
+	if err != nil {
+		return zero, err
+	}
+
+	// The result holds its own meta, since only the first caller options are used on the shared call.
+	type result struct {
+		val  T
+		meta ResponseMeta
+	}
+
+	val, shared, err := client.coalescer.do(ctx, coalesceKey(client.routePrefix, u, ro), func(callCtx context.Context) (any, error) {
+		callRo := *ro
+		res := result{}
+		callRo.meta = &res.meta
+
+		var err error
+		res.val, err = do[T](callCtx, client, uri, &callRo)
+		return res, err
+	})
+
+	res, ok := val.(result)
+	if !ok {
+		return zero, err
+	}
+
+	if ro.meta != nil {
+		*ro.meta = res.meta
+	}
+
+	if shared {
+		client.Logger.Debug("request coalesced", "apiMethod", ro.apiMethod, "uri", u.String())
+	}
+
+	return res.val, err
+}
@@ -0,0 +1,203 @@
+package internal
+
+import (
+	"context"
+	"io"
+	"leago/internal/mock"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// blockingDoer holds every request until released, counting the upstream calls.
+type blockingDoer struct {
+	calls   atomic.Int32
+	started chan struct{}
+	release chan struct{}
+}
+
+func newBlockingDoer() *blockingDoer {
+	return &blockingDoer{
+		started: make(chan struct{}, 100),
+		release: make(chan struct{}),
+	}
+}
+
+func (d *blockingDoer) Do(req *http.Request) (*http.Response, error) {
+	d.calls.Add(1)
+	d.started <- struct{}{}
+
+	select {
+	case <-d.release:
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{HeaderAppRateLimit: []string{"20:1"}},
+		Body:       io.NopCloser(strings.NewReader(`{"name":"shared"}`)),
+	}, nil
+}
+
+func newCoalescingClient(doer Doer) *Client {
+	return NewHttpClient(doer, slog.Default(), "test", "apiKey", WithCoalescing())
+}
+
+// waitForWaiters blocks until the in-flight calls of the client have n waiters in total.
+func waitForWaiters(t *testing.T, client *Client, n int) {
+	t.Helper()
+	require.Eventually(t, func() bool {
+		client.coalescer.mu.Lock()
+		defer client.coalescer.mu.Unlock()
+
+		waiters := 0
+		for _, cl := range client.coalescer.calls {
+			waiters += cl.waiters
+		}
+		return waiters == n
+	}, 5*time.Second, time.Millisecond)
+}
+
+func TestCoalescingSharesCall(t *testing.T) {
+	doer := newBlockingDoer()
+	client := newCoalescingClient(doer)
+
+	const callers = 5
+	var wg sync.WaitGroup
+	results := make([]Response, callers)
+	metas := make([]ResponseMeta, callers)
+	errs := make([]error, callers)
+
+	for i := range callers {
+		wg.Go(func() {
+			results[i], errs[i] = AuthRequest[Response](
+				context.Background(),
+				client,
+				"http://testexample.com",
+				WithResponseMeta(&metas[i]),
+			)
+		})
+	}
+
+	<-doer.started
+	waitForWaiters(t, client, callers)
+	close(doer.release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), doer.calls.Load())
+	for i := range callers {
+		require.NoError(t, errs[i])
+		assert.Equal(t, "shared", results[i].Name)
+		assert.Equal(t, http.StatusOK, metas[i].StatusCode)
+	}
+}
+
+func TestCoalescingCallerCancellation(t *testing.T) {
+	doer := newBlockingDoer()
+	client := newCoalescingClient(doer)
+
+	var wg sync.WaitGroup
+	var sharedErr error
+	var shared Response
+	wg.Go(func() {
+		shared, sharedErr = AuthRequest[Response](context.Background(), client, "http://testexample.com")
+	})
+	<-doer.started
+
+	// A second caller gives up, without affecting the first one.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := AuthRequest[Response](ctx, client, "http://testexample.com")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	close(doer.release)
+	wg.Wait()
+
+	require.NoError(t, sharedErr)
+	assert.Equal(t, "shared", shared.Name)
+	assert.Equal(t, int32(1), doer.calls.Load())
+}
+
+func TestCoalescingAllCallersCancelled(t *testing.T) {
+	doer := newBlockingDoer()
+	client := newCoalescingClient(doer)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		_, err := AuthRequest[Response](ctx, client, "http://testexample.com")
+		done <- err
+	}()
+	<-doer.started
+
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+
+	// The upstream call was cancelled, so a new caller starts a fresh one.
+	close(doer.release)
+	got, err := AuthRequest[Response](context.Background(), client, "http://testexample.com")
+	require.NoError(t, err)
+	assert.Equal(t, "shared", got.Name)
+	assert.Equal(t, int32(2), doer.calls.Load())
+}
+
+func TestCoalescingDistinctRequests(t *testing.T) {
+	doer := newBlockingDoer()
+	close(doer.release)
+	client := newCoalescingClient(doer)
+
+	requests := [][]RequestOption{
+		nil,
+		{WithParam("page", "2")},
+		{withApiKey("otherKey")},
+		{WithHttpMethod(http.MethodPost)},
+		{WithCacheBypass()},
+		{WithCacheTTL(time.Minute)},
+	}
+
+	var wg sync.WaitGroup
+	for _, opts := range requests {
+		wg.Go(func() {
+			_, err := Request[Response](context.Background(), client, "http://testexample.com", append([]RequestOption{withApiKey("apiKey")}, opts...)...)
+			assert.NoError(t, err)
+		})
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(len(requests)), doer.calls.Load())
+}
+
+func TestCoalesceKeyCacheOptions(t *testing.T) {
+	u, err := url.Parse("http://testexample.com")
+	require.NoError(t, err)
+
+	ttl := time.Minute
+	plain := coalesceKey("br1", u, &requestOptions{})
+	assert.NotEqual(t, plain, coalesceKey("br1", u, &requestOptions{cacheBypass: true}))
+	assert.NotEqual(t, plain, coalesceKey("br1", u, &requestOptions{cacheTTL: &ttl}))
+}
+
+func TestCoalescingKeepsDeadline(t *testing.T) {
+	doer := mock.NewDefaultDoer(http.StatusOK, `{"name":"shared"}`, nil)
+	client := newCoalescingClient(doer)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	_, err := AuthRequest[Response](ctx, client, "http://testexample.com")
+	require.NoError(t, err)
+
+	want, _ := ctx.Deadline()
+	got, ok := doer.CapturedReq.Context().Deadline()
+	require.True(t, ok)
+	assert.Equal(t, want, got)
+}
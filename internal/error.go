@@ -1,16 +1,68 @@
 package internal
 
-import "fmt"
+import (
+	"encoding/json"
+	"leago/apierror"
+	"net/http"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
 
-type RiotError struct {
-	StatusCode int
-	Status     string
-	Body       string
+type (
+	// RiotError is kept as an alias, the public type lives in the apierror package.
+	RiotError = apierror.RiotError
+
+	// riotErrorBody is the error payload sent by Riot.
+	riotErrorBody struct {
+		Status struct {
+			Message    string `json:"message"`
+			StatusCode int    `json:"status_code"`
+		} `json:"status"`
+	}
+)
+
+// maxSnippetLength limits how much of a body is kept on decode errors.
+const maxSnippetLength = 256
+
+// newRiotError builds the error for a non-OK response, parsing the Riot error body when possible.
+func newRiotError(req *http.Request, resp *http.Response, body []byte, ro *requestOptions) *RiotError {
+	rErr := &RiotError{
+		StatusCode:    resp.StatusCode,
+		Status:        resp.Status,
+		Body:          strings.TrimSpace(string(body)),
+		RateLimitType: resp.Header.Get(headerRateLimitType),
+		Endpoint:      req.URL.Path,
+		ApiMethod:     ro.apiMethod,
+	}
+
+	var parsed riotErrorBody
+	if err := json.Unmarshal(body, &parsed); err == nil {
+		rErr.Message = parsed.Status.Message
+	}
+
+	if retryAfter, ok := ParseRetryAfter(resp.Header.Get(headerRetryAfter), time.Now()); ok {
+		rErr.RetryAfter = retryAfter
+	}
+
+	return rErr
 }
 
-func (e *RiotError) Error() string {
-	if e.Body != "" {
-		return fmt.Sprintf("riot api error: %s (body: %s)", e.Status, e.Body)
+// newDecodeError wraps an unmarshal error with the apiMethod and the start of the body.
+func newDecodeError(err error, body []byte, ro *requestOptions) *apierror.DecodeError {
+	snippet := string(body)
+	if len(snippet) > maxSnippetLength {
+		// Back off to the start of a rune so the cut doesn't split a multi-byte character.
+		end := maxSnippetLength
+		for end > 0 && !utf8.RuneStart(snippet[end]) {
+			end--
+		}
+		snippet = snippet[:end] + "..."
+	}
+
+	return &apierror.DecodeError{
+		ApiMethod: ro.apiMethod,
+		Snippet:   strings.TrimSpace(snippet),
+		Err:       err,
 	}
-	return fmt.Sprintf("riot api error: %s", e.Status)
 }
@@ -1,9 +1,17 @@
 package internal
 
 import (
+	"context"
+	"leago/apierror"
+	"leago/internal/mock"
+	"net/http"
+	"strings"
 	"testing"
+	"time"
+	"unicode/utf8"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestRiotError(t *testing.T) {
@@ -22,3 +30,47 @@ func TestRiotError(t *testing.T) {
 	}
 	assert.NotContains(t, riotErrorNoBody.Error(), "body:")
 }
+
+func TestNewRiotError(t *testing.T) {
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "https://br1.api.riotgames.com/lol/test?x=1", http.NoBody)
+	require.NoError(t, err)
+
+	resp := mock.NewResponse(
+		http.StatusTooManyRequests,
+		`{"status":{"message":"Rate limit exceeded","status_code":429}}`,
+		headerRetryAfter, "5",
+		headerRateLimitType, "method",
+	)
+
+	rErr := newRiotError(req, resp, []byte(`{"status":{"message":"Rate limit exceeded","status_code":429}}`), &requestOptions{apiMethod: "Test.Method"})
+
+	assert.Equal(t, http.StatusTooManyRequests, rErr.StatusCode)
+	assert.Equal(t, "Rate limit exceeded", rErr.Message)
+	assert.Equal(t, "method", rErr.RateLimitType)
+	assert.Equal(t, 5*time.Second, rErr.RetryAfter)
+	assert.Equal(t, "/lol/test", rErr.Endpoint)
+	assert.Equal(t, "Test.Method", rErr.ApiMethod)
+	assert.ErrorIs(t, rErr, apierror.ErrRateLimited)
+
+	plain := newRiotError(req, mock.NewResponse(http.StatusBadRequest, "Error"), []byte("Error"), &requestOptions{})
+	assert.Empty(t, plain.Message)
+	assert.Equal(t, "Error", plain.Body)
+}
+
+func TestNewDecodeError(t *testing.T) {
+	body := []byte(strings.Repeat("a", maxSnippetLength*2))
+	dErr := newDecodeError(assert.AnError, body, &requestOptions{apiMethod: "Test.Method"})
+
+	assert.Equal(t, "Test.Method", dErr.ApiMethod)
+	assert.Len(t, dErr.Snippet, maxSnippetLength+len("..."))
+	assert.ErrorIs(t, dErr, assert.AnError)
+}
+
+func TestNewDecodeErrorMultiByteCut(t *testing.T) {
+	// "é" takes two bytes, starting one byte before the snippet limit.
+	body := []byte(strings.Repeat("a", maxSnippetLength-1) + strings.Repeat("é", 10))
+	dErr := newDecodeError(assert.AnError, body, &requestOptions{})
+
+	assert.True(t, utf8.ValidString(dErr.Snippet))
+	assert.Equal(t, strings.Repeat("a", maxSnippetLength-1)+"...", dErr.Snippet)
+}
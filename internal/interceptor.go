@@ -0,0 +1,46 @@
+package internal
+
+import (
+	"leago/regions"
+	"net/http"
+)
+
+type (
+	// RequestInfo is the request metadata passed to the interceptors and key providers.
+	RequestInfo struct {
+		ApiMethod string
+		Route     string
+		// Product is the game of the client, empty for APIs shared by every product like Account-V1.
+		Product regions.Product
+		// Attempt starts at 1 and increases on every retry.
+		Attempt int
+	}
+
+	// DoFunc executes a request, either the next interceptor or the Doer itself.
+	DoFunc func(req *http.Request) (*http.Response, error)
+
+	// Interceptor wraps every attempt sent to the Doer.
+	// It can mutate the request, short-circuit by returning its own response or inspect the response from next.
+	// Responses returned without calling next use no rate limit budget and aren't reported to the key provider.
+	Interceptor func(req *http.Request, info RequestInfo, next DoFunc) (*http.Response, error)
+)
+
+// WithInterceptors appends interceptors to the client chain, the first one being the outermost.
+func WithInterceptors(interceptors ...Interceptor) ClientOption {
+	return func(c *Client) {
+		c.interceptors = append(c.interceptors, interceptors...)
+	}
+}
+
+// doChain sends the request through the interceptors and then to last, which sends it to the Doer.
+func (c *Client) doChain(req *http.Request, info RequestInfo, last DoFunc) (*http.Response, error) {
+	next := last
+	for i := len(c.interceptors) - 1; i >= 0; i-- {
+		interceptor, inner := c.interceptors[i], next
+		next = func(req *http.Request) (*http.Response, error) {
+			return interceptor(req, info, inner)
+		}
+	}
+
+	return next(req)
+}
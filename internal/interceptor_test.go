@@ -0,0 +1,145 @@
+package internal
+
+import (
+	"context"
+	"io"
+	"leago/internal/mock"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInterceptors(t *testing.T) {
+	doer := mock.NewDefaultDoer(http.StatusOK, `{"name":"upstream"}`, nil)
+
+	var order []string
+	var infos []RequestInfo
+	record := func(name string) Interceptor {
+		return func(req *http.Request, info RequestInfo, next DoFunc) (*http.Response, error) {
+			order = append(order, name+":before")
+			infos = append(infos, info)
+			req.Header.Set("X-"+name, "true")
+
+			resp, err := next(req)
+
+			order = append(order, name+":after")
+			return resp, err
+		}
+	}
+
+	client := NewHttpClient(
+		doer,
+		slog.Default(),
+		"br1",
+		"apiKey",
+		WithInterceptors(record("First")),
+		WithInterceptors(record("Second")),
+	)
+
+	got, err := AuthRequest[Response](context.Background(), client, "http://testexample.com", WithApiMethod("Test.Method"))
+	require.NoError(t, err)
+
+	assert.Equal(t, "upstream", got.Name)
+	assert.Equal(t, []string{"First:before", "Second:before", "Second:after", "First:after"}, order)
+	assert.Equal(t, RequestInfo{ApiMethod: "Test.Method", Route: "br1", Attempt: 1}, infos[0])
+	assert.Equal(t, "true", doer.CapturedReq.Header.Get("X-First"))
+	assert.Equal(t, "true", doer.CapturedReq.Header.Get("X-Second"))
+}
+
+func TestInterceptorShortCircuit(t *testing.T) {
+	tests := []struct {
+		name        string
+		interceptor Interceptor
+		wantName    string
+		wantErr     bool
+		wantRiotErr bool
+	}{
+		{
+			name: "fake response",
+			interceptor: func(_ *http.Request, _ RequestInfo, _ DoFunc) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(`{"name":"fake"}`)),
+				}, nil
+			},
+			wantName: "fake",
+		},
+		{
+			name: "fault injection",
+			interceptor: func(_ *http.Request, _ RequestInfo, _ DoFunc) (*http.Response, error) {
+				return &http.Response{StatusCode: http.StatusForbidden}, nil
+			},
+			wantErr:     true,
+			wantRiotErr: true,
+		},
+		{
+			name: "nil response",
+			interceptor: func(_ *http.Request, _ RequestInfo, _ DoFunc) (*http.Response, error) {
+				return nil, nil
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doer := mock.NewDefaultDoer(http.StatusOK, `{"name":"upstream"}`, nil)
+			client := NewHttpClient(doer, slog.Default(), "br1", "apiKey", WithInterceptors(tt.interceptor))
+
+			got, err := AuthRequest[Response](context.Background(), client, "http://testexample.com")
+			assert.Nil(t, doer.CapturedReq)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				if tt.wantRiotErr {
+					var rErr *RiotError
+					assert.ErrorAs(t, err, &rErr)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantName, got.Name)
+		})
+	}
+}
+
+func TestInterceptorShortCircuitSkipsAccounting(t *testing.T) {
+	doer := mock.NewDefaultDoer(http.StatusOK, `{"name":"upstream"}`, nil)
+	keys := &rotatingKeys{keys: []string{"first"}}
+
+	// An injected fault looking like a Riot rate limit must not block the limiter or disable the key.
+	fault := func(_ *http.Request, _ RequestInfo, _ DoFunc) (*http.Response, error) {
+		return mock.NewResponse(
+			http.StatusTooManyRequests,
+			"",
+			headerRetryAfter, "10",
+			HeaderAppRateLimit, "1:10",
+			HeaderAppRateLimitCount, "1:10",
+		), nil
+	}
+	client := NewHttpClient(
+		doer,
+		slog.Default(),
+		"br1",
+		"",
+		WithKeyProvider(keys),
+		WithInterceptors(fault),
+		WithDefaultRetryPolicy(NoRetry()),
+	)
+
+	_, err := AuthRequest[Response](context.Background(), client, "http://testexample.com")
+	var rErr *RiotError
+	require.ErrorAs(t, err, &rErr)
+	assert.Equal(t, http.StatusTooManyRequests, rErr.StatusCode)
+
+	assert.Nil(t, doer.CapturedReq)
+	assert.Empty(t, client.Limiter.buckets)
+	assert.Equal(t, 1, keys.handed)
+	assert.Zero(t, keys.observed)
+	assert.Zero(t, keys.pending)
+}
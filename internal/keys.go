@@ -0,0 +1,104 @@
+package internal
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+)
+
+type (
+	// KeyProvider supplies the API key of each request and receives the responses sent with it.
+	KeyProvider interface {
+		// Key returns the API key to be used on the request, like a key registered for its product.
+		Key(ctx context.Context, info RequestInfo) (string, error)
+		// Observe reports the status and headers of a response received with the key.
+		Observe(key string, info RequestInfo, statusCode int, header http.Header)
+	}
+
+	// KeyReleaser is implemented by providers tracking the keys in use.
+	KeyReleaser interface {
+		// Release reports a key returned by Key that was never sent, so no response will be observed for it.
+		Release(key string, info RequestInfo)
+	}
+)
+
+var errNoFreshKey = errors.New("every key of the provider was rejected")
+
+// WithKeyProvider sets the provider used by authenticated requests instead of the static API key.
+func WithKeyProvider(provider KeyProvider) ClientOption {
+	return func(c *Client) {
+		c.keys = provider
+	}
+}
+
+// resolveKey returns the API key for an authenticated request.
+func (c *Client) resolveKey(ctx context.Context, info RequestInfo) (string, error) {
+	if c.keys == nil {
+		return c.apiKey, nil
+	}
+	return c.keys.Key(ctx, info)
+}
+
+// nextKey sets the API key of the next attempt of an authenticated request.
+// Keys are resolved for every attempt, only once the request is about to be sent, so cached and coalesced
+// requests never take a key from the provider. Keys already rejected are released and never used again.
+func (c *Client) nextKey(ctx context.Context, ro *requestOptions, info RequestInfo, rejected map[string]bool) error {
+	if !ro.auth {
+		return nil
+	}
+
+	key, err := c.resolveKey(ctx, info)
+	if err != nil {
+		return err
+	}
+
+	if rejected[key] {
+		c.releaseKey(ro, info, key)
+		return errNoFreshKey
+	}
+
+	ro.apiKey = key
+	return nil
+}
+
+// observeKey reports the response to the provider, if any.
+func (c *Client) observeKey(ro *requestOptions, info RequestInfo, resp *http.Response) {
+	if c.keys == nil || !ro.auth {
+		return
+	}
+	c.keys.Observe(ro.apiKey, info, resp.StatusCode, resp.Header)
+}
+
+// releaseKey reports a key that was never sent to the provider, if it tracks the keys in use.
+func (c *Client) releaseKey(ro *requestOptions, info RequestInfo, key string) {
+	if c.keys == nil || !ro.auth {
+		return
+	}
+	if releaser, ok := c.keys.(KeyReleaser); ok {
+		releaser.Release(key, info)
+	}
+}
+
+// failover reports if a request rejected with 403 can be retried with another key of the provider.
+// The rejected key is never used again by the request, so a provider handing out revoked keys can't retry it forever.
+func (c *Client) failover(ctx context.Context, ro *requestOptions, statusCode int, rejected map[string]bool) bool {
+	if c.keys == nil || !ro.auth || statusCode != http.StatusForbidden || ctx.Err() != nil {
+		return false
+	}
+
+	rejected[ro.apiKey] = true
+	return true
+}
+
+// limitScope returns the rate limiter scope of the request.
+// Riot limits are per API key, so each key of a provider has its own buckets.
+func (c *Client) limitScope(ro *requestOptions) string {
+	if c.keys == nil || ro.apiKey == "" {
+		return c.routePrefix
+	}
+
+	sum := sha256.Sum256([]byte(ro.apiKey))
+	return c.routePrefix + "#" + hex.EncodeToString(sum[:4])
+}
@@ -0,0 +1,235 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"leago/cache"
+	"leago/internal/mock"
+	"log/slog"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeKeys hands the keys in order, moving to the next one when a key is rejected.
+type fakeKeys struct {
+	keys     []string
+	current  int
+	observed []int
+	err      error
+}
+
+func (f *fakeKeys) Key(_ context.Context, _ RequestInfo) (string, error) {
+	if f.err != nil {
+		return "", f.err
+	}
+	return f.keys[f.current], nil
+}
+
+func (f *fakeKeys) Observe(_ string, _ RequestInfo, statusCode int, _ http.Header) {
+	f.observed = append(f.observed, statusCode)
+	if statusCode == http.StatusForbidden && f.current < len(f.keys)-1 {
+		f.current++
+	}
+}
+
+// rotatingKeys hands the keys in turn, tracking the keys in flight like a pool balancing by usage.
+type rotatingKeys struct {
+	mu       sync.Mutex
+	keys     []string
+	handed   int
+	pending  int
+	observed int
+}
+
+func (r *rotatingKeys) Key(_ context.Context, _ RequestInfo) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := r.keys[r.handed%len(r.keys)]
+	r.handed++
+	r.pending++
+	return key, nil
+}
+
+func (r *rotatingKeys) Observe(_ string, _ RequestInfo, _ int, _ http.Header) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.pending--
+	r.observed++
+}
+
+func (r *rotatingKeys) Release(_ string, _ RequestInfo) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.pending--
+}
+
+func TestKeyProvider(t *testing.T) {
+	tests := []struct {
+		name       string
+		keys       *fakeKeys
+		responses  []*http.Response
+		wantKeys   []string
+		wantErr    bool
+		wantStatus int
+	}{
+		{
+			name:      "uses provider key",
+			keys:      &fakeKeys{keys: []string{"first"}},
+			responses: []*http.Response{mock.NewResponse(http.StatusOK, `{"name":"ok"}`)},
+			wantKeys:  []string{"first"},
+		},
+		{
+			name: "fails over on forbidden",
+			keys: &fakeKeys{keys: []string{"first", "second"}},
+			responses: []*http.Response{
+				mock.NewResponse(http.StatusForbidden, ""),
+				mock.NewResponse(http.StatusOK, `{"name":"ok"}`),
+			},
+			wantKeys: []string{"first", "second"},
+		},
+		{
+			name: "stops when no other key",
+			keys: &fakeKeys{keys: []string{"first"}},
+			responses: []*http.Response{
+				mock.NewResponse(http.StatusForbidden, ""),
+			},
+			wantKeys:   []string{"first"},
+			wantErr:    true,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:     "provider error",
+			keys:     &fakeKeys{err: errors.New("no key")},
+			wantKeys: []string{},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doer := &mock.SequenceDoer{Responses: tt.responses}
+			client := NewHttpClient(doer, slog.Default(), "br1", "", WithKeyProvider(tt.keys), WithDefaultRetryPolicy(NoRetry()))
+
+			got, err := AuthRequest[Response](context.Background(), client, "http://testexample.com")
+
+			sentKeys := make([]string, 0, len(doer.CapturedReqs))
+			for _, req := range doer.CapturedReqs {
+				sentKeys = append(sentKeys, req.Header.Get(apiTokenHeader))
+			}
+			assert.Equal(t, tt.wantKeys, sentKeys)
+			assert.Len(t, tt.keys.observed, len(doer.CapturedReqs))
+
+			if tt.wantErr {
+				require.Error(t, err)
+				if tt.wantStatus != 0 {
+					var rErr *RiotError
+					require.ErrorAs(t, err, &rErr)
+					assert.Equal(t, tt.wantStatus, rErr.StatusCode)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "ok", got.Name)
+		})
+	}
+}
+
+func TestKeyProviderPending(t *testing.T) {
+	tests := []struct {
+		name       string
+		doer       Doer
+		requests   int
+		wantHanded int
+	}{
+		{
+			name:       "response observed",
+			doer:       &mock.SequenceDoer{Responses: []*http.Response{mock.NewResponse(http.StatusOK, `{"name":"ok"}`)}},
+			requests:   1,
+			wantHanded: 1,
+		},
+		{
+			name:       "cache hit takes no key",
+			doer:       &mock.SequenceDoer{Responses: []*http.Response{mock.NewResponse(http.StatusOK, `{"name":"ok"}`)}},
+			requests:   2,
+			wantHanded: 1,
+		},
+		{
+			name:       "transport error releases key",
+			doer:       mock.NewDefaultDoer(http.StatusOK, "", errors.New("connection reset")),
+			requests:   1,
+			wantHanded: 1,
+		},
+		{
+			name:       "rejected key handed again is released",
+			doer:       &mock.SequenceDoer{Responses: []*http.Response{mock.NewResponse(http.StatusForbidden, "")}},
+			requests:   1,
+			wantHanded: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keys := &rotatingKeys{keys: []string{"first"}}
+			client := NewHttpClient(
+				tt.doer,
+				slog.Default(),
+				"br1",
+				"",
+				WithKeyProvider(keys),
+				WithCache(cache.NewLRU(10), nil, 0),
+				WithDefaultRetryPolicy(NoRetry()),
+			)
+
+			for range tt.requests {
+				_, _ = AuthRequest[Response](context.Background(), client, "http://testexample.com", WithCacheTTL(time.Minute))
+			}
+
+			assert.Equal(t, tt.wantHanded, keys.handed)
+			assert.Zero(t, keys.pending)
+		})
+	}
+}
+
+func TestKeyProviderCoalescing(t *testing.T) {
+	doer := newBlockingDoer()
+	keys := &rotatingKeys{keys: []string{"first", "second", "third"}}
+	client := NewHttpClient(doer, slog.Default(), "br1", "", WithKeyProvider(keys), WithCoalescing())
+
+	const callers = 3
+	var wg sync.WaitGroup
+	for range callers {
+		wg.Go(func() {
+			got, err := AuthRequest[Response](context.Background(), client, "http://testexample.com")
+			assert.NoError(t, err)
+			assert.Equal(t, "shared", got.Name)
+		})
+	}
+
+	<-doer.started
+	waitForWaiters(t, client, callers)
+	close(doer.release)
+	wg.Wait()
+
+	// Identical requests share a call even though the provider rotates keys.
+	assert.Equal(t, int32(1), doer.calls.Load())
+	assert.Equal(t, 1, keys.handed)
+	assert.Zero(t, keys.pending)
+}
+
+func TestLimitScope(t *testing.T) {
+	static := NewHttpClient(http.DefaultClient, slog.Default(), "br1", "apiKey")
+	assert.Equal(t, "br1", static.limitScope(&requestOptions{apiKey: "apiKey"}))
+
+	pooled := NewHttpClient(http.DefaultClient, slog.Default(), "br1", "", WithKeyProvider(&fakeKeys{}))
+	first := pooled.limitScope(&requestOptions{apiKey: "first"})
+	second := pooled.limitScope(&requestOptions{apiKey: "second"})
+	assert.NotEqual(t, first, second)
+	assert.NotContains(t, first, "first")
+}
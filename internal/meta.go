@@ -0,0 +1,73 @@
+package internal
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// ResponseMeta is the information about the last HTTP response received for a request.
+type ResponseMeta struct {
+	StatusCode int
+	Header     http.Header
+	// Limits and counts parsed from the X-App-Rate-Limit and X-Method-Rate-Limit headers.
+	AppLimits    []RateLimitWindow
+	AppCounts    []RateLimitWindow
+	MethodLimits []RateLimitWindow
+	MethodCounts []RateLimitWindow
+	// Duration is the total time spent on the request, including retries and rate limit waits.
+	Duration time.Duration
+	Attempts int
+	URL      string
+	// FromCache is set when the response was served from the cache, fresh or stale.
+	FromCache bool
+}
+
+// fill sets the meta with the response information.
+func (m *ResponseMeta) fill(req *http.Request, resp *http.Response, attempt int, start time.Time) {
+	if m == nil {
+		return
+	}
+
+	*m = ResponseMeta{
+		StatusCode:   resp.StatusCode,
+		Header:       resp.Header,
+		AppLimits:    ParseRateLimitHeader(resp.Header.Get(HeaderAppRateLimit)),
+		AppCounts:    ParseRateLimitHeader(resp.Header.Get(HeaderAppRateLimitCount)),
+		MethodLimits: ParseRateLimitHeader(resp.Header.Get(headerMethodRateLimit)),
+		MethodCounts: ParseRateLimitHeader(resp.Header.Get(headerMethodRateLimitCount)),
+		Duration:     time.Since(start),
+		Attempts:     attempt,
+		URL:          req.URL.String(),
+	}
+}
+
+// fillFromCache sets the meta of a response served from the cache, clearing the information of any previous response.
+func (m *ResponseMeta) fillFromCache(u *url.URL) {
+	if m == nil {
+		return
+	}
+
+	*m = ResponseMeta{
+		FromCache: true,
+		URL:       u.String(),
+	}
+}
+
+// fillFromStale marks the meta as served from a stale cache entry after the request failed with err.
+// The information of a failed Riot response is kept, other failures leave no response to describe.
+func (m *ResponseMeta) fillFromStale(u *url.URL, err error) {
+	if m == nil {
+		return
+	}
+
+	var rErr *RiotError
+	if !errors.As(err, &rErr) {
+		m.fillFromCache(u)
+		return
+	}
+
+	m.FromCache = true
+	m.URL = u.String()
+}
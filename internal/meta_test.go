@@ -0,0 +1,143 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"leago/internal/mock"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithResponseMeta(t *testing.T) {
+	tests := []struct {
+		name         string
+		responses    []*http.Response
+		wantStatus   int
+		wantAttempts int
+		wantErr      bool
+	}{
+		{
+			name: "success",
+			responses: []*http.Response{
+				mock.NewResponse(
+					http.StatusOK,
+					`{"name":"meta"}`,
+					HeaderAppRateLimit, "20:1,100:120",
+					HeaderAppRateLimitCount, "1:1,5:120",
+					headerMethodRateLimit, "50:10",
+					headerMethodRateLimitCount, "2:10",
+					"Date", "Mon, 01 Jan 2024 00:00:00 GMT",
+				),
+			},
+			wantStatus:   http.StatusOK,
+			wantAttempts: 1,
+		},
+		{
+			name: "riot error after retry",
+			responses: []*http.Response{
+				mock.NewResponse(http.StatusBadGateway, ""),
+				mock.NewResponse(http.StatusNotFound, "", HeaderAppRateLimit, "20:1,100:120"),
+			},
+			wantStatus:   http.StatusNotFound,
+			wantAttempts: 2,
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doer := &mock.SequenceDoer{Responses: tt.responses}
+			client := NewHttpClient(
+				doer,
+				slog.Default(),
+				"test",
+				"apiKey",
+				WithDefaultRetryPolicy(RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond}),
+			)
+
+			var meta ResponseMeta
+			_, err := AuthRequest[Response](
+				context.Background(),
+				client,
+				"http://testexample.com/path?x=1",
+				WithResponseMeta(&meta),
+			)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.wantStatus, meta.StatusCode)
+			assert.Equal(t, tt.wantAttempts, meta.Attempts)
+			assert.Equal(t, "http://testexample.com/path?x=1", meta.URL)
+			assert.Positive(t, meta.Duration)
+			assert.Equal(t, []RateLimitWindow{
+				{Count: 20, Period: time.Second},
+				{Count: 100, Period: 120 * time.Second},
+			}, meta.AppLimits)
+
+			if !tt.wantErr {
+				assert.Equal(t, []RateLimitWindow{{Count: 5, Period: 120 * time.Second}}, meta.AppCounts[1:])
+				assert.Equal(t, []RateLimitWindow{{Count: 50, Period: 10 * time.Second}}, meta.MethodLimits)
+				assert.Equal(t, []RateLimitWindow{{Count: 2, Period: 10 * time.Second}}, meta.MethodCounts)
+				assert.Equal(t, "Mon, 01 Jan 2024 00:00:00 GMT", meta.Header.Get("Date"))
+			}
+		})
+	}
+}
+
+func TestResponseMetaFromCache(t *testing.T) {
+	u, err := url.Parse("http://testexample.com/path")
+	require.NoError(t, err)
+
+	// A meta reused from an earlier request, or filled by the failed attempt of the same one.
+	previous := ResponseMeta{
+		StatusCode: http.StatusServiceUnavailable,
+		Header:     http.Header{headerRetryAfter: []string{"1"}},
+		AppLimits:  []RateLimitWindow{{Count: 20, Period: time.Second}},
+		Duration:   time.Second,
+		Attempts:   3,
+		URL:        "http://testexample.com/other",
+	}
+	cached := ResponseMeta{FromCache: true, URL: "http://testexample.com/path"}
+	failed := previous
+	failed.FromCache = true
+	failed.URL = "http://testexample.com/path"
+
+	tests := []struct {
+		name string
+		fill func(m *ResponseMeta)
+		want ResponseMeta
+	}{
+		{
+			name: "fresh entry clears previous response",
+			fill: func(m *ResponseMeta) { m.fillFromCache(u) },
+			want: cached,
+		},
+		{
+			name: "stale entry keeps failed riot response",
+			fill: func(m *ResponseMeta) { m.fillFromStale(u, &RiotError{StatusCode: http.StatusServiceUnavailable}) },
+			want: failed,
+		},
+		{
+			name: "stale entry after transport error clears previous response",
+			fill: func(m *ResponseMeta) { m.fillFromStale(u, errors.New("connection reset")) },
+			want: cached,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			meta := previous
+			tt.fill(&meta)
+			assert.Equal(t, tt.want, meta)
+		})
+	}
+}
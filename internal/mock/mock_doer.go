@@ -26,3 +26,38 @@ func NewDefaultDoer(statusCode int, body string, err error) *Doer {
 		Err: err,
 	}
 }
+
+// SequenceDoer returns the responses in order, repeating the last one once exhausted.
+type SequenceDoer struct {
+	CapturedReqs []*http.Request
+	CapturedBody []string
+	Responses    []*http.Response
+}
+
+func (m *SequenceDoer) Do(req *http.Request) (*http.Response, error) {
+	m.CapturedReqs = append(m.CapturedReqs, req)
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	m.CapturedBody = append(m.CapturedBody, string(body))
+
+	idx := min(len(m.CapturedReqs), len(m.Responses)) - 1
+	return m.Responses[idx], nil
+}
+
+// NewResponse returns a response with the status, body and headers as key/value pairs.
+func NewResponse(statusCode int, body string, headers ...string) *http.Response {
+	header := http.Header{}
+	for i := 0; i+1 < len(headers); i += 2 {
+		header.Set(headers[i], headers[i+1])
+	}
+
+	return &http.Response{
+		StatusCode: statusCode,
+		Status:     http.StatusText(statusCode),
+		Header:     header,
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+}
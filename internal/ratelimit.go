@@ -0,0 +1,260 @@
+package internal
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+type (
+	// RateLimiter keeps track of the Riot application and method rate limits.
+	// Limits are learned from the response headers, so the first requests are never throttled.
+	RateLimiter struct {
+		mu      sync.Mutex
+		buckets map[string]*bucket
+		now     func() time.Time
+	}
+
+	// RateLimitWindow is a single "count:seconds" pair sent on the rate limit headers.
+	RateLimitWindow struct {
+		Count  int
+		Period time.Duration
+	}
+
+	// bucket is the state of a single application or method limit.
+	bucket struct {
+		windows      []*window
+		blockedUntil time.Time
+	}
+
+	window struct {
+		limit  int
+		period time.Duration
+		count  int
+		start  time.Time
+	}
+)
+
+const (
+	// HeaderAppRateLimit and HeaderAppRateLimitCount hold the application limits of the API key, read by key providers.
+	HeaderAppRateLimit         = "X-App-Rate-Limit"
+	HeaderAppRateLimitCount    = "X-App-Rate-Limit-Count"
+	headerMethodRateLimit      = "X-Method-Rate-Limit"
+	headerMethodRateLimitCount = "X-Method-Rate-Limit-Count"
+	headerRateLimitType        = "X-Rate-Limit-Type"
+	headerRetryAfter           = "Retry-After"
+
+	rateLimitTypeApplication = "application"
+)
+
+// NewRateLimiter returns a rate limiter without any known limits.
+func NewRateLimiter() *RateLimiter {
+	return &RateLimiter{
+		buckets: make(map[string]*bucket),
+		now:     time.Now,
+	}
+}
+
+// Wait blocks until a request for the route and apiMethod can be sent, reserving a slot on its buckets.
+// Returns the context error if it's done before the request is allowed.
+func (rl *RateLimiter) Wait(ctx context.Context, route, apiMethod string) error {
+	if rl == nil {
+		return nil
+	}
+
+	for {
+		rl.mu.Lock()
+		now := rl.now()
+		buckets := rl.bucketsFor(route, apiMethod)
+
+		var delay time.Duration
+		for _, b := range buckets {
+			delay = max(delay, b.delay(now))
+		}
+
+		if delay <= 0 {
+			for _, b := range buckets {
+				b.take(now)
+			}
+			rl.mu.Unlock()
+			return nil
+		}
+		rl.mu.Unlock()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// Update refreshes the buckets for the route and apiMethod with the limits and counts sent by Riot.
+// A 429 response blocks the bucket that was exceeded for the Retry-After duration.
+func (rl *RateLimiter) Update(route, apiMethod string, statusCode int, header http.Header) {
+	if rl == nil {
+		return
+	}
+
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	now := rl.now()
+	buckets := rl.bucketsFor(route, apiMethod)
+
+	buckets[0].update(
+		ParseRateLimitHeader(header.Get(HeaderAppRateLimit)),
+		ParseRateLimitHeader(header.Get(HeaderAppRateLimitCount)),
+		now,
+	)
+
+	if len(buckets) > 1 {
+		buckets[1].update(
+			ParseRateLimitHeader(header.Get(headerMethodRateLimit)),
+			ParseRateLimitHeader(header.Get(headerMethodRateLimitCount)),
+			now,
+		)
+	}
+
+	if statusCode != http.StatusTooManyRequests {
+		return
+	}
+
+	retryAfter, ok := ParseRetryAfter(header.Get(headerRetryAfter), now)
+	if !ok {
+		return
+	}
+
+	// Method and service limits only affect the method bucket, so other methods can still be used.
+	blocked := buckets[0]
+	if header.Get(headerRateLimitType) != rateLimitTypeApplication && len(buckets) > 1 {
+		blocked = buckets[1]
+	}
+	blocked.blockedUntil = now.Add(retryAfter)
+}
+
+// bucketsFor returns the application bucket and, if an apiMethod is set, the method bucket.
+// Must be called with the lock held.
+func (rl *RateLimiter) bucketsFor(route, apiMethod string) []*bucket {
+	out := []*bucket{rl.bucket("app:" + route)}
+	if apiMethod != "" {
+		out = append(out, rl.bucket("method:"+route+":"+apiMethod))
+	}
+	return out
+}
+
+func (rl *RateLimiter) bucket(key string) *bucket {
+	b, ok := rl.buckets[key]
+	if !ok {
+		b = &bucket{}
+		rl.buckets[key] = b
+	}
+	return b
+}
+
+// delay returns how long until a request can be sent on the bucket.
+func (b *bucket) delay(now time.Time) time.Duration {
+	delay := b.blockedUntil.Sub(now)
+	for _, w := range b.windows {
+		if w.start.IsZero() || w.count < w.limit {
+			continue
+		}
+		delay = max(delay, w.start.Add(w.period).Sub(now))
+	}
+	return delay
+}
+
+// take reserves one request on every window of the bucket.
+func (b *bucket) take(now time.Time) {
+	for _, w := range b.windows {
+		w.reset(now)
+		w.count++
+	}
+}
+
+// update replaces the bucket limits, keeping the local counts when they are ahead of Riot.
+func (b *bucket) update(limits, counts []RateLimitWindow, now time.Time) {
+	if len(limits) == 0 {
+		return
+	}
+
+	windows := make([]*window, 0, len(limits))
+	for _, l := range limits {
+		w := &window{limit: l.Count, period: l.Period}
+		for _, old := range b.windows {
+			if old.period == l.Period {
+				w.count, w.start = old.count, old.start
+			}
+		}
+
+		w.reset(now)
+		for _, c := range counts {
+			if c.Period == l.Period && c.Count > w.count {
+				w.count = c.Count
+			}
+		}
+
+		windows = append(windows, w)
+	}
+	b.windows = windows
+}
+
+// reset starts a new window if the current one already expired.
+func (w *window) reset(now time.Time) {
+	if w.start.IsZero() || !now.Before(w.start.Add(w.period)) {
+		w.start = now
+		w.count = 0
+	}
+}
+
+// ParseRateLimitHeader parses a rate limit header value like "20:1,100:120".
+// Malformed pairs are ignored.
+func ParseRateLimitHeader(value string) []RateLimitWindow {
+	if value == "" {
+		return nil
+	}
+
+	pairs := strings.Split(value, ",")
+	out := make([]RateLimitWindow, 0, len(pairs))
+	for _, pair := range pairs {
+		count, seconds, ok := strings.Cut(strings.TrimSpace(pair), ":")
+		if !ok {
+			continue
+		}
+
+		c, err := strconv.Atoi(count)
+		if err != nil {
+			continue
+		}
+
+		s, err := strconv.Atoi(seconds)
+		if err != nil || s <= 0 {
+			continue
+		}
+
+		out = append(out, RateLimitWindow{Count: c, Period: time.Duration(s) * time.Second})
+	}
+	return out
+}
+
+// ParseRetryAfter parses the Retry-After header, that can be sent as seconds or as a HTTP date.
+func ParseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(max(seconds, 0)) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(date.Sub(now), 0), true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,195 @@
+package internal
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func newTestLimiter() (*RateLimiter, *fakeClock) {
+	clock := &fakeClock{now: time.Unix(1700000000, 0)}
+	rl := NewRateLimiter()
+	rl.now = clock.Now
+	return rl, clock
+}
+
+func limitHeader(app, appCount, method, methodCount string) http.Header {
+	h := http.Header{}
+	h.Set(HeaderAppRateLimit, app)
+	h.Set(HeaderAppRateLimitCount, appCount)
+	h.Set(headerMethodRateLimit, method)
+	h.Set(headerMethodRateLimitCount, methodCount)
+	return h
+}
+
+func TestParseRateLimitHeader(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  []RateLimitWindow
+	}{
+		{
+			name:  "empty",
+			value: "",
+			want:  nil,
+		},
+		{
+			name:  "multiple windows",
+			value: "20:1,100:120",
+			want: []RateLimitWindow{
+				{Count: 20, Period: time.Second},
+				{Count: 100, Period: 120 * time.Second},
+			},
+		},
+		{
+			name:  "malformed pairs ignored",
+			value: "20:1,abc,5:x,7:0, 3:10",
+			want: []RateLimitWindow{
+				{Count: 20, Period: time.Second},
+				{Count: 3, Period: 10 * time.Second},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ParseRateLimitHeader(tt.value))
+		})
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	d, ok := ParseRetryAfter("3", now)
+	require.True(t, ok)
+	assert.Equal(t, 3*time.Second, d)
+
+	d, ok = ParseRetryAfter(now.Add(10*time.Second).Format(http.TimeFormat), now)
+	require.True(t, ok)
+	assert.Equal(t, 10*time.Second, d)
+
+	_, ok = ParseRetryAfter("", now)
+	assert.False(t, ok)
+
+	_, ok = ParseRetryAfter("soon", now)
+	assert.False(t, ok)
+}
+
+func TestRateLimiterUnknownLimits(t *testing.T) {
+	rl, _ := newTestLimiter()
+
+	for range 100 {
+		require.NoError(t, rl.Wait(context.Background(), "br1", "Test.Method"))
+	}
+}
+
+func TestRateLimiterBlocksOnAppLimit(t *testing.T) {
+	rl, clock := newTestLimiter()
+
+	require.NoError(t, rl.Wait(context.Background(), "br1", "Test.Method"))
+	rl.Update("br1", "Test.Method", http.StatusOK, limitHeader("2:10", "1:10", "100:10", "1:10"))
+
+	require.NoError(t, rl.Wait(context.Background(), "br1", "Test.Other"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, rl.Wait(ctx, "br1", "Test.Method"), context.DeadlineExceeded)
+
+	// Other routes have their own application bucket.
+	require.NoError(t, rl.Wait(context.Background(), "na1", "Test.Method"))
+
+	clock.Advance(10 * time.Second)
+	require.NoError(t, rl.Wait(context.Background(), "br1", "Test.Method"))
+}
+
+func TestRateLimiterBlocksOnMethodLimit(t *testing.T) {
+	rl, clock := newTestLimiter()
+
+	require.NoError(t, rl.Wait(context.Background(), "br1", "Test.Method"))
+	rl.Update("br1", "Test.Method", http.StatusOK, limitHeader("100:10", "1:10", "1:10", "1:10"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, rl.Wait(ctx, "br1", "Test.Method"), context.DeadlineExceeded)
+
+	// Other methods on the same route are not affected.
+	require.NoError(t, rl.Wait(context.Background(), "br1", "Test.Other"))
+
+	clock.Advance(10 * time.Second)
+	require.NoError(t, rl.Wait(context.Background(), "br1", "Test.Method"))
+}
+
+func TestRateLimiterRetryAfter(t *testing.T) {
+	tests := []struct {
+		name         string
+		limitType    string
+		otherBlocked bool
+	}{
+		{
+			name:         "method limit",
+			limitType:    "method",
+			otherBlocked: false,
+		},
+		{
+			name:         "application limit",
+			limitType:    "application",
+			otherBlocked: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rl, clock := newTestLimiter()
+
+			h := http.Header{}
+			h.Set(headerRetryAfter, "5")
+			h.Set(headerRateLimitType, tt.limitType)
+			rl.Update("br1", "Test.Method", http.StatusTooManyRequests, h)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+			defer cancel()
+			assert.ErrorIs(t, rl.Wait(ctx, "br1", "Test.Method"), context.DeadlineExceeded)
+
+			otherCtx, otherCancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+			defer otherCancel()
+			err := rl.Wait(otherCtx, "br1", "Test.Other")
+			if tt.otherBlocked {
+				assert.ErrorIs(t, err, context.DeadlineExceeded)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			clock.Advance(5 * time.Second)
+			require.NoError(t, rl.Wait(context.Background(), "br1", "Test.Method"))
+		})
+	}
+}
+
+func TestRateLimiterNil(t *testing.T) {
+	var rl *RateLimiter
+	require.NoError(t, rl.Wait(context.Background(), "br1", "Test.Method"))
+	rl.Update("br1", "Test.Method", http.StatusOK, http.Header{})
+}
@@ -3,13 +3,25 @@ package internal
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"io"
+	"leago/cache"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 )
 
-const apiTokenHeader = "X-Riot-Token" // #nosec Header name, not credential
+// NoContent is the response type of requests without a response body, like updates sent with PUT.
+type NoContent struct{}
+
+const (
+	apiTokenHeader      = "X-Riot-Token" // #nosec Header name, not credential
+	authorizationHeader = "Authorization"
+)
+
+var errNilResponse = errors.New("doer returned a nil response without error")
 
 // AuthRequest makes a authenticated request with the provided client and returns the decode value to the expected generic type.
 func AuthRequest[T any](ctx context.Context, client *Client, uri string, opts ...RequestOption) (T, error) {
@@ -17,7 +29,7 @@ func AuthRequest[T any](ctx context.Context, client *Client, uri string, opts ..
 		ctx,
 		client,
 		uri,
-		append(opts, withApiKey(client.apiKey))...,
+		append(opts, withAuth())...,
 	)
 }
 
@@ -28,17 +40,15 @@ func Request[T any](ctx context.Context, client *Client, uri string, opts ...Req
 		o(&ro)
 	}
 
-	req, err := buildRequest(ctx, uri, &ro)
-	if err != nil {
-		var zero T
-		return zero, err
+	if client.coalescer != nil && (ro.httpMethod == "" || ro.httpMethod == http.MethodGet) {
+		return coalesce[T](ctx, client, uri, &ro)
 	}
 
-	return do[T](client, req, &ro)
+	return do[T](ctx, client, uri, &ro)
 }
 
-// buildRequest mounts a new http request with all passed options.
-func buildRequest(ctx context.Context, uri string, opts *requestOptions) (*http.Request, error) {
+// buildURL parses the uri and adds the request params to its query.
+func buildURL(uri string, opts *requestOptions) (*url.URL, error) {
 	u, err := url.Parse(uri)
 	if err != nil {
 		return nil, err
@@ -52,6 +62,11 @@ func buildRequest(ctx context.Context, uri string, opts *requestOptions) (*http.
 
 	u.RawQuery = query.Encode()
 
+	return u, nil
+}
+
+// buildRequest mounts a new http request with all passed options.
+func buildRequest(ctx context.Context, u *url.URL, opts *requestOptions) (*http.Request, error) {
 	var bodyReader io.Reader = http.NoBody
 	if opts.body != nil {
 		b, err := json.Marshal(opts.body)
@@ -75,49 +90,203 @@ func buildRequest(ctx context.Context, uri string, opts *requestOptions) (*http.
 		req.Header.Set("Content-Type", "application/json")
 	}
 
-	if opts.apiKey != "" {
+	// The "me" endpoints authenticate the player with the access token alone, the API key isn't sent along it.
+	switch {
+	case opts.bearerToken != "":
+		req.Header.Set(authorizationHeader, "Bearer "+opts.bearerToken)
+	case opts.apiKey != "":
 		req.Header.Set(apiTokenHeader, opts.apiKey)
 	}
 
 	return req, nil
 }
 
-// do Executes the request itself and handles the status and unmarshal.
-func do[T any](client *Client, req *http.Request, ro *requestOptions) (T, error) {
+// do executes the request, serving it from the cache when possible, and decodes the response.
+func do[T any](ctx context.Context, client *Client, uri string, ro *requestOptions) (T, error) {
 	var respData T
 
+	u, err := buildURL(uri, ro)
+	if err != nil {
+		return respData, err
+	}
+
 	logger := client.Logger.With(
 		"apiMethod", ro.apiMethod,
 		"httpMethod", ro.httpMethod,
-		"uri", req.URL.String(),
+		"uri", u.String(),
 		"route", client.routePrefix,
 	)
 
-	resp, err := client.Http.Do(req)
+	key := cacheKey(client.routePrefix, u)
+	ttl := client.cacheTTL(ro)
+
+	var stale *cache.Entry
+	if ttl > 0 && !ro.cacheBypass {
+		if entry, ok := client.cache.Get(key); ok {
+			if !entry.Expired(time.Now()) {
+				logger.Debug("cache hit")
+				ro.meta.fillFromCache(u)
+				return decode[T](entry.Value, ro, logger)
+			}
+			stale = &entry
+		}
+	}
+
+	body, err := fetch(ctx, client, u, ro, logger)
 	if err != nil {
-		logger.Error("request failed", "error", err)
+		if stale != nil && client.canServeStale(ctx, err, *stale) {
+			logger.Warn("serving stale cache entry", "error", err)
+			ro.meta.fillFromStale(u, err)
+			return decode[T](stale.Value, ro, logger)
+		}
 		return respData, err
 	}
+
+	respData, err = decode[T](body, ro, logger)
+	if err == nil && ttl > 0 {
+		client.cache.Set(key, body, ttl)
+	}
+
+	return respData, err
+}
+
+// fetch executes the request, retrying transient failures, and returns the body of the successful response.
+// The request is rebuilt for every attempt, since the body reader is consumed when sent.
+func fetch(ctx context.Context, client *Client, u *url.URL, ro *requestOptions, logger *slog.Logger) ([]byte, error) {
+	policy := client.retryPolicy
+	if ro.retryPolicy != nil {
+		policy = *ro.retryPolicy
+	}
+
+	start := time.Now()
+	rejected := make(map[string]bool)
+	var riotErr *RiotError
+	for attempt := 1; ; attempt++ {
+		info := RequestInfo{
+			ApiMethod: ro.apiMethod,
+			Route:     client.routePrefix,
+			Product:   client.product,
+			Attempt:   attempt,
+		}
+
+		if err := client.nextKey(ctx, ro, info, rejected); err != nil {
+			if riotErr != nil {
+				// Failing over found no usable key, report the rejection instead.
+				return nil, riotErr
+			}
+			return nil, err
+		}
+
+		req, err := buildRequest(ctx, u, ro)
+		if err != nil {
+			client.releaseKey(ro, info, ro.apiKey)
+			return nil, err
+		}
+
+		attemptLogger := logger.With("attempt", attempt)
+
+		resp, body, err := send(client, req, ro, info, attemptLogger)
+		if err != nil {
+			return nil, err
+		}
+
+		ro.meta.fill(req, resp, attempt, start)
+
+		if resp.StatusCode >= 200 && resp.StatusCode <= 300 {
+			return body, nil
+		}
+
+		attemptLogger.Log(ctx, ro.statusLevel(resp.StatusCode), "non-OK HTTP status", "status", resp.StatusCode)
+		riotErr = newRiotError(req, resp, body, ro)
+
+		if client.failover(ctx, ro, resp.StatusCode, rejected) {
+			attemptLogger.Warn("API key rejected, failing over to the next key")
+			continue
+		}
+
+		delay, retry := policy.retryDelay(req.Method, attempt, resp.StatusCode, resp.Header, time.Now())
+		if !retry {
+			return nil, riotErr
+		}
+
+		attemptLogger.Info("retrying request", "delay", delay)
+		if !sleep(ctx, delay) {
+			return nil, riotErr
+		}
+	}
+}
+
+// send executes a single attempt through the interceptors and reads the response body.
+// Only attempts reaching the Doer wait for the rate limiter and update it and the key provider,
+// responses built by interceptors use no rate limit budget and aren't mistaken for Riot responses.
+func send(client *Client, req *http.Request, ro *requestOptions, info RequestInfo, logger *slog.Logger) (*http.Response, []byte, error) {
+	sent := false
+	resp, err := client.doChain(req, info, func(req *http.Request) (*http.Response, error) {
+		sent = true
+		return upstream(client, req, ro, info, logger)
+	})
+	if !sent {
+		// The key was never sent, an interceptor answered or failed before reaching the Doer.
+		client.releaseKey(ro, info, ro.apiKey)
+	}
+
+	if err != nil {
+		logger.Error("request failed", "error", err)
+		return nil, nil, err
+	}
+
+	if resp == nil {
+		logger.Error("request failed", "error", errNilResponse)
+		return nil, nil, errNilResponse
+	}
+
+	// Short-circuited responses built by interceptors may not have a body.
+	if resp.Body == nil {
+		resp.Body = http.NoBody
+	}
 	defer func() { _ = resp.Body.Close() }()
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
 		logger.Error("failed to read response body", "error", err)
-		return respData, err
+		return nil, nil, err
 	}
 
-	if resp.StatusCode < 200 || resp.StatusCode > 300 {
-		logger.Warn("non-OK HTTP status", "status", resp.StatusCode)
-		return respData, &RiotError{
-			StatusCode: resp.StatusCode,
-			Status:     resp.Status,
-			Body:       strings.TrimSpace(string(body)),
-		}
+	return resp, body, nil
+}
+
+// upstream waits for the rate limiter and sends the request to the Doer, the innermost step of the interceptor chain.
+// The response updates the rate limiter and is reported to the key provider, the key is released if none arrives.
+func upstream(client *Client, req *http.Request, ro *requestOptions, info RequestInfo, logger *slog.Logger) (*http.Response, error) {
+	scope := client.limitScope(ro)
+	if err := client.Limiter.Wait(req.Context(), scope, ro.apiMethod); err != nil {
+		logger.Warn("rate limit wait cancelled", "error", err)
+		client.releaseKey(ro, info, ro.apiKey)
+		return nil, err
+	}
+
+	resp, err := client.Http.Do(req)
+	if err != nil || resp == nil {
+		client.releaseKey(ro, info, ro.apiKey)
+		return resp, err
+	}
+
+	client.Limiter.Update(scope, ro.apiMethod, resp.StatusCode, resp.Header)
+	client.observeKey(ro, info, resp)
+
+	return resp, nil
+}
+
+// decode unmarshals the body into the expected type.
+func decode[T any](body []byte, ro *requestOptions, logger *slog.Logger) (T, error) {
+	var respData T
+	if _, ok := any(respData).(NoContent); ok {
+		return respData, nil
 	}
 
 	if err := json.Unmarshal(body, &respData); err != nil {
 		logger.Error("failed to unmarshal response body", "error", err)
-		return respData, err
+		return respData, newDecodeError(err, body, ro)
 	}
 
 	return respData, nil
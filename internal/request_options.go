@@ -1,25 +1,54 @@
 package internal
 
+import (
+	"log/slog"
+	"slices"
+	"time"
+)
+
 type (
 	requestOptions struct {
-		apiKey     string
-		apiMethod  string
-		httpMethod string
-		body       any
-		params     map[string]string
+		auth        bool
+		apiKey      string
+		bearerToken string
+		apiMethod   string
+		httpMethod  string
+		body        any
+		params      map[string]string
+		retryPolicy *RetryPolicy
+		meta        *ResponseMeta
+		cacheBypass bool
+		cacheTTL    *time.Duration
+		expected    []int
 	}
 
 	RequestOption func(*requestOptions)
 )
 
-// withApiKey applies the API Key for the AuthRequest.
+// withApiKey applies a fixed API Key to the request.
 func withApiKey(apiKey string) RequestOption {
 	return func(ro *requestOptions) {
 		ro.apiKey = apiKey
 	}
 }
 
-// WithApiMethod sets the API method used (Logging).
+// withAuth marks the request as authenticated, the API key is resolved from the client when sent.
+func withAuth() RequestOption {
+	return func(ro *requestOptions) {
+		ro.auth = true
+	}
+}
+
+// WithBearerToken sets the RSO access token of the player, used by the "me" endpoints.
+// The token replaces the API key, the X-Riot-Token header isn't sent.
+// Responses of requests with a bearer token are never cached.
+func WithBearerToken(token string) RequestOption {
+	return func(ro *requestOptions) {
+		ro.bearerToken = token
+	}
+}
+
+// WithApiMethod sets the API method used (Logging and method rate limiting).
 func WithApiMethod(method string) RequestOption {
 	return func(ro *requestOptions) {
 		ro.apiMethod = method
@@ -56,3 +85,47 @@ func WithParam(key, val string) RequestOption {
 		ro.params[key] = val
 	}
 }
+
+// WithRetryPolicy overrides the client retry policy for the request.
+func WithRetryPolicy(policy RetryPolicy) RequestOption {
+	return func(ro *requestOptions) {
+		ro.retryPolicy = &policy
+	}
+}
+
+// WithResponseMeta fills the meta with the status, headers and rate limits of the last response.
+func WithResponseMeta(meta *ResponseMeta) RequestOption {
+	return func(ro *requestOptions) {
+		ro.meta = meta
+	}
+}
+
+// WithExpectedStatus marks error statuses that are a normal result of the request, like 404 for a player not in game.
+// They are still returned as errors, but logged at debug level instead of warn.
+func WithExpectedStatus(statusCodes ...int) RequestOption {
+	return func(ro *requestOptions) {
+		ro.expected = append(ro.expected, statusCodes...)
+	}
+}
+
+// WithCacheBypass skips the cache lookup, the fresh response is still stored.
+func WithCacheBypass() RequestOption {
+	return func(ro *requestOptions) {
+		ro.cacheBypass = true
+	}
+}
+
+// WithCacheTTL overrides the cache TTL of the request, zero disables caching it.
+func WithCacheTTL(ttl time.Duration) RequestOption {
+	return func(ro *requestOptions) {
+		ro.cacheTTL = &ttl
+	}
+}
+
+// statusLevel returns the level used to log a non-OK response with the status.
+func (ro *requestOptions) statusLevel(statusCode int) slog.Level {
+	if slices.Contains(ro.expected, statusCode) {
+		return slog.LevelDebug
+	}
+	return slog.LevelWarn
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"leago/apierror"
 	"leago/internal/mock"
 	"log/slog"
 	"net/http"
@@ -51,6 +52,7 @@ func TestAuthRequest(t *testing.T) {
 		wantTokenParam string
 		wantErr        bool
 		wantRiotErr    bool
+		wantDecodeErr  bool
 	}{
 		{
 			name:    "invalid URI",
@@ -101,6 +103,7 @@ func TestAuthRequest(t *testing.T) {
 			httpBody:       io.NopCloser(strings.NewReader("invalid json")),
 			wantErr:        true,
 			wantRiotErr:    false,
+			wantDecodeErr:  true,
 		},
 		{
 			name:           "success",
@@ -164,6 +167,11 @@ func TestAuthRequest(t *testing.T) {
 					assert.Equal(t, tt.httpStatusCode, rErr.StatusCode)
 				}
 
+				if tt.wantDecodeErr {
+					var dErr *apierror.DecodeError
+					assert.ErrorAs(t, err, &dErr)
+				}
+
 				return
 			}
 
@@ -177,3 +185,65 @@ func TestAuthRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestBearerToken(t *testing.T) {
+	mockDoer := mock.NewDefaultDoer(http.StatusOK, `{"name":"me"}`, nil)
+	client := newTestClient(mockDoer)
+
+	got, err := AuthRequest[Response](context.Background(), client, "http://testexample.com", WithBearerToken("accessToken"))
+	require.Nil(t, err)
+
+	assert.Equal(t, "me", got.Name)
+	assert.Equal(t, "Bearer accessToken", mockDoer.CapturedReq.Header.Get(authorizationHeader))
+	assert.Empty(t, mockDoer.CapturedReq.Header.Values(apiTokenHeader))
+}
+
+func TestNoContent(t *testing.T) {
+	mockDoer := mock.NewDefaultDoer(http.StatusNoContent, "", nil)
+	client := newTestClient(mockDoer)
+
+	_, err := AuthRequest[NoContent](
+		context.Background(),
+		client,
+		"http://testexample.com",
+		WithHttpMethod(http.MethodPut),
+		WithBody(PostRequest{Name: "update"}),
+	)
+	require.Nil(t, err)
+	assert.Equal(t, http.MethodPut, mockDoer.CapturedReq.Method)
+}
+
+func TestExpectedStatusLogLevel(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     []RequestOption
+		wantWarn bool
+	}{
+		{
+			name:     "unexpected status",
+			wantWarn: true,
+		},
+		{
+			name: "expected status",
+			opts: []RequestOption{WithExpectedStatus(http.StatusNotFound)},
+		},
+		{
+			name:     "other expected status",
+			opts:     []RequestOption{WithExpectedStatus(http.StatusNoContent)},
+			wantWarn: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf strings.Builder
+			client := newTestClient(mock.NewDefaultDoer(http.StatusNotFound, `{}`, nil))
+			client.Logger = slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelWarn}))
+
+			_, err := AuthRequest[Response](context.Background(), client, "http://testexample.com", tt.opts...)
+			require.Error(t, err)
+
+			assert.Equal(t, tt.wantWarn, strings.Contains(buf.String(), "non-OK HTTP status"))
+		})
+	}
+}
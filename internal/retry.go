@@ -0,0 +1,110 @@
+package internal
+
+import (
+	"context"
+	"math/rand/v2"
+	"net/http"
+	"time"
+)
+
+// RetryPolicy controls how transient Riot errors (429 and 5xx) are retried.
+// Server errors of non-idempotent requests, like POST, are only retried when Riot sends a Retry-After.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one. Values below 2 disable retries.
+	MaxAttempts int
+	// BaseDelay is the backoff before the first retry, doubled on every following attempt.
+	BaseDelay time.Duration
+	// MaxDelay caps the exponential backoff. Retry-After values sent by Riot are always honored.
+	MaxDelay time.Duration
+}
+
+// DefaultRetryPolicy returns the policy used when none is configured.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 3,
+		BaseDelay:   500 * time.Millisecond,
+		MaxDelay:    10 * time.Second,
+	}
+}
+
+// NoRetry returns a policy that never retries.
+func NoRetry() RetryPolicy {
+	return RetryPolicy{MaxAttempts: 1}
+}
+
+// retryDelay returns how long to wait before the next attempt and if it should be made at all.
+// Rate limited requests were never processed and are always retried. Server errors may come after the
+// server applied the request, so they are only retried for idempotent methods, unless Riot sent a Retry-After.
+func (p RetryPolicy) retryDelay(method string, attempt, statusCode int, header http.Header, now time.Time) (time.Duration, bool) {
+	if attempt >= p.MaxAttempts {
+		return 0, false
+	}
+
+	retryAfter, hasRetryAfter := ParseRetryAfter(header.Get(headerRetryAfter), now)
+
+	switch statusCode {
+	case http.StatusTooManyRequests:
+		if hasRetryAfter {
+			return retryAfter, true
+		}
+		return p.backoff(attempt), true
+	case http.StatusServiceUnavailable:
+		if hasRetryAfter {
+			return retryAfter, true
+		}
+		return p.backoff(attempt), idempotent(method)
+	case http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusGatewayTimeout:
+		return p.backoff(attempt), idempotent(method)
+	default:
+		return 0, false
+	}
+}
+
+// idempotent reports if the request can be sent again without side effects, an empty method is a GET.
+func idempotent(method string) bool {
+	switch method {
+	case "", http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// backoff returns the jittered exponential delay for the given attempt, starting at 1.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	delay := p.BaseDelay
+	for i := 1; i < attempt && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+
+	if p.MaxDelay > 0 {
+		delay = min(delay, p.MaxDelay)
+	}
+
+	if delay <= 0 {
+		return 0
+	}
+
+	// Equal jitter, keeps at least half of the delay to avoid hammering the API.
+	half := delay / 2
+	return half + rand.N(delay-half+1) // #nosec G404 Jitter doesn't need a secure source.
+}
+
+// sleep waits for the delay, returning false if the context is done or its deadline would be exceeded.
+func sleep(ctx context.Context, delay time.Duration) bool {
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+		return false
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
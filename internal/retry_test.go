@@ -0,0 +1,288 @@
+package internal
+
+import (
+	"context"
+	"leago/internal/mock"
+	"log/slog"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryDelay(t *testing.T) {
+	policy := RetryPolicy{
+		MaxAttempts: 3,
+		BaseDelay:   100 * time.Millisecond,
+		MaxDelay:    time.Second,
+	}
+	now := time.Now()
+
+	retryAfter := http.Header{}
+	retryAfter.Set(headerRetryAfter, "7")
+
+	tests := []struct {
+		name       string
+		method     string
+		attempt    int
+		statusCode int
+		header     http.Header
+		wantRetry  bool
+		wantMin    time.Duration
+		wantMax    time.Duration
+	}{
+		{
+			name:       "not found is not retried",
+			attempt:    1,
+			statusCode: http.StatusNotFound,
+			wantRetry:  false,
+		},
+		{
+			name:       "max attempts reached",
+			attempt:    3,
+			statusCode: http.StatusInternalServerError,
+			wantRetry:  false,
+		},
+		{
+			name:       "server error uses backoff",
+			attempt:    1,
+			statusCode: http.StatusBadGateway,
+			wantRetry:  true,
+			wantMin:    50 * time.Millisecond,
+			wantMax:    100 * time.Millisecond,
+		},
+		{
+			name:       "backoff grows with attempts",
+			attempt:    2,
+			statusCode: http.StatusGatewayTimeout,
+			wantRetry:  true,
+			wantMin:    100 * time.Millisecond,
+			wantMax:    200 * time.Millisecond,
+		},
+		{
+			name:       "rate limited honors retry after",
+			attempt:    1,
+			statusCode: http.StatusTooManyRequests,
+			header:     retryAfter,
+			wantRetry:  true,
+			wantMin:    7 * time.Second,
+			wantMax:    7 * time.Second,
+		},
+		{
+			name:       "rate limited without retry after",
+			attempt:    1,
+			statusCode: http.StatusTooManyRequests,
+			wantRetry:  true,
+			wantMin:    50 * time.Millisecond,
+			wantMax:    100 * time.Millisecond,
+		},
+		{
+			name:       "post server error is not retried",
+			method:     http.MethodPost,
+			attempt:    1,
+			statusCode: http.StatusBadGateway,
+			wantRetry:  false,
+		},
+		{
+			name:       "post unavailable without retry after is not retried",
+			method:     http.MethodPost,
+			attempt:    1,
+			statusCode: http.StatusServiceUnavailable,
+			wantRetry:  false,
+		},
+		{
+			name:       "post unavailable honors retry after",
+			method:     http.MethodPost,
+			attempt:    1,
+			statusCode: http.StatusServiceUnavailable,
+			header:     retryAfter,
+			wantRetry:  true,
+			wantMin:    7 * time.Second,
+			wantMax:    7 * time.Second,
+		},
+		{
+			name:       "post rate limited is retried",
+			method:     http.MethodPost,
+			attempt:    1,
+			statusCode: http.StatusTooManyRequests,
+			wantRetry:  true,
+			wantMin:    50 * time.Millisecond,
+			wantMax:    100 * time.Millisecond,
+		},
+		{
+			name:       "put server error uses backoff",
+			method:     http.MethodPut,
+			attempt:    1,
+			statusCode: http.StatusInternalServerError,
+			wantRetry:  true,
+			wantMin:    50 * time.Millisecond,
+			wantMax:    100 * time.Millisecond,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delay, retry := policy.retryDelay(tt.method, tt.attempt, tt.statusCode, tt.header, now)
+			require.Equal(t, tt.wantRetry, retry)
+			if !tt.wantRetry {
+				return
+			}
+			assert.GreaterOrEqual(t, delay, tt.wantMin)
+			assert.LessOrEqual(t, delay, tt.wantMax)
+		})
+	}
+}
+
+func TestBackoffCapped(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 20, BaseDelay: time.Second, MaxDelay: 2 * time.Second}
+	for attempt := 1; attempt < 20; attempt++ {
+		assert.LessOrEqual(t, policy.backoff(attempt), 2*time.Second)
+	}
+}
+
+func TestSleep(t *testing.T) {
+	assert.True(t, sleep(context.Background(), time.Millisecond))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.False(t, sleep(ctx, time.Second))
+
+	deadlineCtx, deadlineCancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer deadlineCancel()
+	assert.False(t, sleep(deadlineCtx, time.Minute))
+}
+
+func TestRequestRetry(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		responses  []*http.Response
+		policy     RetryPolicy
+		wantCalls  int
+		wantErr    bool
+		wantStatus int
+	}{
+		{
+			name:   "retries until success",
+			method: http.MethodPut,
+			responses: []*http.Response{
+				mock.NewResponse(http.StatusServiceUnavailable, "down"),
+				mock.NewResponse(http.StatusTooManyRequests, "", headerRetryAfter, "0"),
+				mock.NewResponse(http.StatusOK, `{"name":"retried"}`),
+			},
+			policy:    RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond},
+			wantCalls: 3,
+		},
+		{
+			name:   "gives up after max attempts",
+			method: http.MethodPut,
+			responses: []*http.Response{
+				mock.NewResponse(http.StatusInternalServerError, "err"),
+				mock.NewResponse(http.StatusInternalServerError, "err"),
+			},
+			policy:     RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond},
+			wantCalls:  2,
+			wantErr:    true,
+			wantStatus: http.StatusInternalServerError,
+		},
+		{
+			name:   "client errors are not retried",
+			method: http.MethodPut,
+			responses: []*http.Response{
+				mock.NewResponse(http.StatusBadRequest, "bad"),
+			},
+			policy:     RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond},
+			wantCalls:  1,
+			wantErr:    true,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "no retry policy",
+			method: http.MethodPut,
+			responses: []*http.Response{
+				mock.NewResponse(http.StatusServiceUnavailable, "down"),
+			},
+			policy:     NoRetry(),
+			wantCalls:  1,
+			wantErr:    true,
+			wantStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name:   "post server errors are not retried",
+			method: http.MethodPost,
+			responses: []*http.Response{
+				mock.NewResponse(http.StatusBadGateway, "err"),
+				mock.NewResponse(http.StatusOK, `{"name":"retried"}`),
+			},
+			policy:     RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond},
+			wantCalls:  1,
+			wantErr:    true,
+			wantStatus: http.StatusBadGateway,
+		},
+		{
+			name:   "post retried when rejected before processing",
+			method: http.MethodPost,
+			responses: []*http.Response{
+				mock.NewResponse(http.StatusTooManyRequests, ""),
+				mock.NewResponse(http.StatusServiceUnavailable, "", headerRetryAfter, "0"),
+				mock.NewResponse(http.StatusOK, `{"name":"retried"}`),
+			},
+			policy:    RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond},
+			wantCalls: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doer := &mock.SequenceDoer{Responses: tt.responses}
+			client := NewHttpClient(doer, slog.Default(), "test", "apiKey")
+
+			got, err := AuthRequest[Response](
+				context.Background(),
+				client,
+				"http://testexample.com",
+				WithHttpMethod(tt.method),
+				WithBody(PostRequest{Name: "body"}),
+				WithRetryPolicy(tt.policy),
+			)
+
+			require.Len(t, doer.CapturedReqs, tt.wantCalls)
+			for _, body := range doer.CapturedBody {
+				assert.Equal(t, `{"name":"body"}`, body)
+			}
+
+			if tt.wantErr {
+				var rErr *RiotError
+				require.ErrorAs(t, err, &rErr)
+				assert.Equal(t, tt.wantStatus, rErr.StatusCode)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "retried", got.Name)
+		})
+	}
+}
+
+func TestRequestRetryClientPolicy(t *testing.T) {
+	doer := &mock.SequenceDoer{
+		Responses: []*http.Response{
+			mock.NewResponse(http.StatusBadGateway, ""),
+			mock.NewResponse(http.StatusOK, `{"name":"ok"}`),
+		},
+	}
+	client := NewHttpClient(
+		doer,
+		slog.Default(),
+		"test",
+		"apiKey",
+		WithDefaultRetryPolicy(RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond}),
+	)
+
+	got, err := AuthRequest[Response](context.Background(), client, "http://testexample.com")
+	require.NoError(t, err)
+	assert.Equal(t, "ok", got.Name)
+	assert.Len(t, doer.CapturedReqs, 2)
+}
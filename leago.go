@@ -2,70 +2,196 @@ package leago
 
 import (
 	"leago/api/lol"
+	"leago/api/lor"
 	"leago/api/riot"
+	"leago/api/tft"
+	"leago/api/val"
+	"leago/apikey"
+	"leago/cache"
+	"leago/ddragon"
 	"leago/internal"
+	"leago/options"
 	"leago/regions"
 	"log/slog"
+	"maps"
 	"net/http"
+	"strings"
+	"time"
 )
 
 // Base client used by region and platform client.
 type (
 	baseClient struct {
-		client internal.Doer
-		logger *slog.Logger
+		client      internal.Doer
+		logger      *slog.Logger
+		limiter     *internal.RateLimiter
+		retryPolicy options.RetryPolicy
+
+		cache        cache.Cache
+		cacheTTLs    map[string]time.Duration
+		staleIfError time.Duration
+
+		coalescing   bool
+		baseURL      func(route string) string
+		interceptors []Interceptor
+		keys         apikey.Provider
+
+		dataDragonURL string
 	}
 
 	Option func(*baseClient)
 
+	// Interceptor wraps every attempt sent to the http client.
+	// It can mutate the request, short-circuit by returning its own response or inspect the response from next.
+	Interceptor = internal.Interceptor
+
+	// RequestInfo is the request metadata passed to the interceptors.
+	RequestInfo = internal.RequestInfo
+
+	// DoFunc executes a request, either the next interceptor or the http client itself.
+	DoFunc = internal.DoFunc
+
 	// RegionClient provides access to all region related APIs.
 	RegionClient struct {
 		*baseClient
 		Riot *riot.RegionClient
+		Lol  *lol.RegionClient
+		Tft  *tft.RegionClient
+		Lor  *lor.RegionClient
 	}
 
 	// PlatformClient provides access to all platform related APIs.
 	PlatformClient struct {
 		*baseClient
 		Lol *lol.PlatformClient
+		Tft *tft.PlatformClient
+	}
+
+	// ShardClient provides access to all VALORANT shard related APIs.
+	ShardClient struct {
+		*baseClient
+		Val *val.ShardClient
 	}
 )
 
 // NewRegionClient returns a new client with access to the region specific APIs.
 func NewRegionClient(region regions.Region, apiKey string, opts ...Option) *RegionClient {
-	rc := &RegionClient{
-		baseClient: newBaseClient(),
-	}
+	return newRegionClient(newBaseClient(opts...), region, apiKey)
+}
 
-	for _, opt := range opts {
-		opt(rc.baseClient)
+// NewPlatformClient returns a new client with access to the platform specific APIs.
+func NewPlatformClient(platform regions.Platform, apiKey string, opts ...Option) *PlatformClient {
+	return newPlatformClient(newBaseClient(opts...), platform, apiKey)
+}
+
+// NewShardClient returns a new client with access to the VALORANT shard specific APIs.
+func NewShardClient(shard regions.Shard, apiKey string, opts ...Option) *ShardClient {
+	return newShardClient(newBaseClient(opts...), shard, apiKey)
+}
+
+// NewDataDragonClient returns a new Data Dragon client, sharing the http client, logger, retry policy and cache options.
+func NewDataDragonClient(opts ...Option) *ddragon.Client {
+	return newBaseClient(opts...).newDataDragonClient()
+}
+
+func newRegionClient(bc *baseClient, region regions.Region, apiKey string) *RegionClient {
+	rc := &RegionClient{
+		baseClient: bc,
 	}
 
-	rc.Riot = riot.NewRegionClient(rc.client, rc.logger, region, apiKey)
+	rc.Riot = riot.NewRegionClient(rc.client, rc.logger, region, apiKey, rc.clientOptions()...)
+	rc.Lol = lol.NewRegionClient(rc.client, rc.logger, region, apiKey, rc.clientOptions()...)
+	rc.Tft = tft.NewRegionClient(rc.client, rc.logger, region, apiKey, rc.clientOptions()...)
+	rc.Lor = lor.NewRegionClient(rc.client, rc.logger, region, apiKey, rc.clientOptions()...)
 
 	return rc
 }
 
-// NewPlatformClient returns a new client with access to the platform specific APIs.
-func NewPlatformClient(platform regions.Platform, apiKey string, opts ...Option) *PlatformClient {
+func newPlatformClient(bc *baseClient, platform regions.Platform, apiKey string) *PlatformClient {
 	pc := &PlatformClient{
-		baseClient: newBaseClient(),
+		baseClient: bc,
+	}
+
+	pc.Lol = lol.NewPlatformClient(pc.client, pc.logger, platform, apiKey, pc.clientOptions()...)
+	pc.Tft = tft.NewPlatformClient(pc.client, pc.logger, platform, apiKey, pc.clientOptions()...)
+
+	return pc
+}
+
+func newShardClient(bc *baseClient, shard regions.Shard, apiKey string) *ShardClient {
+	sc := &ShardClient{
+		baseClient: bc,
+	}
+
+	sc.Val = val.NewShardClient(sc.client, sc.logger, shard, apiKey, sc.clientOptions()...)
+
+	return sc
+}
+
+func newBaseClient(opts ...Option) *baseClient {
+	bc := &baseClient{
+		client:      http.DefaultClient,
+		logger:      slog.New(slog.DiscardHandler),
+		limiter:     internal.NewRateLimiter(),
+		retryPolicy: options.DefaultRetryPolicy(),
+		cacheTTLs:   DefaultCacheTTLs(),
 	}
 
 	for _, opt := range opts {
-		opt(pc.baseClient)
+		opt(bc)
+	}
+
+	return bc
+}
+
+// clientOptions returns the options shared by every internal client created from the base client.
+func (bc *baseClient) clientOptions() []internal.ClientOption {
+	opts := []internal.ClientOption{
+		internal.WithRateLimiter(bc.limiter),
+		internal.WithDefaultRetryPolicy(bc.retryPolicy),
 	}
 
-	pc.Lol = lol.NewPlatformClient(pc.client, pc.logger, platform, apiKey)
+	if bc.cache != nil {
+		opts = append(opts, internal.WithCache(bc.cache, bc.cacheTTLs, bc.staleIfError))
+	}
 
-	return pc
+	if bc.baseURL != nil {
+		opts = append(opts, internal.WithBaseURL(bc.baseURL))
+	}
+
+	if len(bc.interceptors) > 0 {
+		opts = append(opts, internal.WithInterceptors(bc.interceptors...))
+	}
+
+	if bc.keys != nil {
+		opts = append(opts, internal.WithKeyProvider(bc.keys))
+	}
+
+	if bc.coalescing {
+		opts = append(opts, internal.WithCoalescing())
+	}
+
+	return opts
 }
 
-func newBaseClient() *baseClient {
-	return &baseClient{
-		client: http.DefaultClient,
-		logger: slog.New(slog.DiscardHandler),
+func (bc *baseClient) newDataDragonClient() *ddragon.Client {
+	opts := []internal.ClientOption{
+		internal.WithDefaultRetryPolicy(bc.retryPolicy),
+	}
+
+	if bc.cache != nil {
+		opts = append(opts, internal.WithCache(bc.cache, bc.cacheTTLs, bc.staleIfError))
+	}
+
+	if len(bc.interceptors) > 0 {
+		opts = append(opts, internal.WithInterceptors(bc.interceptors...))
+	}
+
+	if bc.coalescing {
+		opts = append(opts, internal.WithCoalescing())
 	}
+
+	return ddragon.NewClient(bc.client, bc.logger, bc.dataDragonURL, opts...)
 }
 
 // Override the default base http client.
@@ -81,3 +207,81 @@ func WithLogger(logger *slog.Logger) Option {
 		bc.logger = logger
 	}
 }
+
+// Override the default retry policy for transient errors (429 and 5xx).
+// Use options.NoRetry() to disable retries.
+func WithRetryPolicy(policy options.RetryPolicy) Option {
+	return func(bc *baseClient) {
+		bc.retryPolicy = policy
+	}
+}
+
+// Enable the response cache, using the DefaultCacheTTLs for each API method.
+// Use cache.NewLRU for a bounded in-memory cache.
+func WithCache(c cache.Cache) Option {
+	return func(bc *baseClient) {
+		bc.cache = c
+	}
+}
+
+// Override the cache TTLs of the given API methods, a zero TTL disables caching the method.
+func WithCacheTTLs(ttls map[string]time.Duration) Option {
+	return func(bc *baseClient) {
+		maps.Copy(bc.cacheTTLs, ttls)
+	}
+}
+
+// Serve expired cache entries for up to maxStale when Riot is failing (transport errors, 429 and 5xx).
+func WithStaleIfError(maxStale time.Duration) Option {
+	return func(bc *baseClient) {
+		bc.staleIfError = maxStale
+	}
+}
+
+// Share a single upstream call between concurrent GET requests with the same URL and API key.
+// Every caller receives the same decoded value, so it must be treated as read-only.
+func WithRequestCoalescing() Option {
+	return func(bc *baseClient) {
+		bc.coalescing = true
+	}
+}
+
+// Override the Riot API base URL, built from the platform or region route.
+// Used to target proxies or local servers, see BaseURLTemplate.
+func WithBaseURL(baseURL func(route string) string) Option {
+	return func(bc *baseClient) {
+		bc.baseURL = baseURL
+	}
+}
+
+// BaseURLTemplate returns a base URL builder replacing {route} on the template.
+// For example: "http://localhost:8080/{route}" or "https://{route}.riot-proxy.internal".
+func BaseURLTemplate(template string) func(route string) string {
+	return func(route string) string {
+		return strings.ReplaceAll(template, "{route}", route)
+	}
+}
+
+// Add interceptors around every attempt sent to the http client, the first one being the outermost.
+// Interceptors run after the rate limiter and are called again on each retry.
+func WithInterceptors(interceptors ...Interceptor) Option {
+	return func(bc *baseClient) {
+		bc.interceptors = append(bc.interceptors, interceptors...)
+	}
+}
+
+// Use a key provider instead of the static API key, like a pool of keys or a key reloaded from a file.
+// The apiKey passed to the client constructor is ignored.
+func WithKeyProvider(provider apikey.Provider) Option {
+	return func(bc *baseClient) {
+		bc.keys = provider
+	}
+}
+
+// Override the Data Dragon CDN base URL, used to target a local mirror with the same layout.
+// WithBaseURL doesn't apply to Data Dragon, since it isn't served by the Riot API routes.
+func WithDataDragonBaseURL(baseURL string) Option {
+	return func(bc *baseClient) {
+		bc.dataDragonURL = baseURL
+	}
+}
@@ -1,12 +1,21 @@
 package leago_test
 
 import (
+	"context"
 	"leago"
+	"leago/api/lol/champion"
+	"leago/apikey"
+	"leago/cache"
+	"leago/internal/mock"
+	"leago/options"
 	"leago/regions"
 	"log/slog"
 	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -29,3 +38,170 @@ func TestNewPlatformClient(t *testing.T) {
 	)
 	require.NotNil(t, client)
 }
+
+func TestNewShardClient(t *testing.T) {
+	client := leago.NewShardClient(
+		regions.ShardEU,
+		"ApiKey",
+		leago.WithClient(http.DefaultClient),
+		leago.WithLogger(slog.Default()),
+	)
+	require.NotNil(t, client)
+	require.NotNil(t, client.Val)
+}
+
+func TestNewPlatformClientWithRetryPolicy(t *testing.T) {
+	client := leago.NewPlatformClient(
+		regions.PlatformBR1,
+		"ApiKey",
+		leago.WithRetryPolicy(options.NoRetry()),
+	)
+	require.NotNil(t, client)
+}
+
+func TestNewPlatformClientWithCache(t *testing.T) {
+	doer := &mock.SequenceDoer{
+		Responses: []*http.Response{
+			mock.NewResponse(http.StatusOK, `{"maxNewPlayerLevel":10}`),
+		},
+	}
+
+	client := leago.NewPlatformClient(
+		regions.PlatformBR1,
+		"ApiKey",
+		leago.WithClient(doer),
+		leago.WithCache(cache.NewLRU(10)),
+		leago.WithCacheTTLs(map[string]time.Duration{champion.MethodGetRotation: time.Minute}),
+		leago.WithStaleIfError(time.Hour),
+		leago.WithRequestCoalescing(),
+	)
+
+	for range 2 {
+		rotation, err := client.Lol.Champion.GetRotation(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, 10, rotation.MaxNewPlayerLevel)
+	}
+	require.Len(t, doer.CapturedReqs, 1)
+}
+
+func TestDefaultCacheTTLs(t *testing.T) {
+	ttls := leago.DefaultCacheTTLs()
+	require.Equal(t, time.Hour, ttls[champion.MethodGetRotation])
+
+	// Changes to the returned map must not leak into the defaults.
+	ttls[champion.MethodGetRotation] = 0
+	require.Equal(t, time.Hour, leago.DefaultCacheTTLs()[champion.MethodGetRotation])
+}
+
+func TestWithBaseURL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/euw1/lol/platform/v3/champion-rotations", r.URL.Path)
+		assert.Equal(t, "ApiKey", r.Header.Get("X-Riot-Token"))
+		_, _ = w.Write([]byte(`{"maxNewPlayerLevel":10}`))
+	}))
+	defer server.Close()
+
+	client := leago.NewPlatformClient(
+		regions.PlatformEUW1,
+		"ApiKey",
+		leago.WithBaseURL(leago.BaseURLTemplate(server.URL+"/{route}")),
+	)
+
+	rotation, err := client.Lol.Champion.GetRotation(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 10, rotation.MaxNewPlayerLevel)
+}
+
+func TestWithInterceptors(t *testing.T) {
+	doer := mock.NewDefaultDoer(http.StatusOK, `{"maxNewPlayerLevel":10}`, nil)
+
+	var info leago.RequestInfo
+	client := leago.NewPlatformClient(
+		regions.PlatformBR1,
+		"ApiKey",
+		leago.WithClient(doer),
+		leago.WithInterceptors(func(req *http.Request, i leago.RequestInfo, next leago.DoFunc) (*http.Response, error) {
+			info = i
+			req.Header.Set("X-Trace", "trace-id")
+			return next(req)
+		}),
+	)
+
+	_, err := client.Lol.Champion.GetRotation(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, champion.MethodGetRotation, info.ApiMethod)
+	assert.Equal(t, string(regions.PlatformBR1), info.Route)
+	assert.Equal(t, regions.ProductLoL, info.Product)
+	assert.Equal(t, "trace-id", doer.CapturedReq.Header.Get("X-Trace"))
+}
+
+func TestWithKeyProvider(t *testing.T) {
+	doer := mock.NewDefaultDoer(http.StatusOK, `{"maxNewPlayerLevel":10}`, nil)
+
+	client := leago.NewPlatformClient(
+		regions.PlatformBR1,
+		"",
+		leago.WithClient(doer),
+		leago.WithKeyProvider(apikey.NewRoundRobin([]string{"first", "second"})),
+	)
+
+	_, err := client.Lol.Champion.GetRotation(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "first", doer.CapturedReq.Header.Get("X-Riot-Token"))
+}
+
+func TestWithProductKeys(t *testing.T) {
+	doer := &mock.SequenceDoer{
+		Responses: []*http.Response{
+			mock.NewResponse(http.StatusOK, `{"maxNewPlayerLevel":10}`),
+			mock.NewResponse(http.StatusOK, `{"puuid":"puuid"}`),
+			mock.NewResponse(http.StatusOK, `{"maxNewPlayerLevel":10}`),
+			mock.NewResponse(http.StatusOK, `{"puuid":"puuid"}`),
+		},
+	}
+
+	pool := apikey.NewRoundRobin(
+		nil,
+		apikey.WithProductKeys(regions.ProductLoL, "lol"),
+		apikey.WithProductKeys(regions.ProductTFT, "tft"),
+	)
+	client := leago.NewPlatformClient(regions.PlatformBR1, "", leago.WithClient(doer), leago.WithKeyProvider(pool))
+
+	for range 2 {
+		_, err := client.Lol.Champion.GetRotation(context.Background())
+		require.NoError(t, err)
+		_, err = client.Tft.Summoner.GetByPUUID(context.Background(), "puuid")
+		require.NoError(t, err)
+	}
+
+	sentKeys := make([]string, 0, len(doer.CapturedReqs))
+	for _, req := range doer.CapturedReqs {
+		sentKeys = append(sentKeys, req.Header.Get("X-Riot-Token"))
+	}
+	assert.Equal(t, []string{"lol", "tft", "lol", "tft"}, sentKeys)
+}
+
+func TestNewDataDragonClient(t *testing.T) {
+	doer := &mock.SequenceDoer{
+		Responses: []*http.Response{
+			mock.NewResponse(http.StatusOK, `["14.1.1","14.1.0"]`),
+		},
+	}
+
+	client := leago.NewDataDragonClient(
+		leago.WithClient(doer),
+		leago.WithCache(cache.NewLRU(10)),
+		leago.WithBaseURL(leago.BaseURLTemplate("http://localhost:8080/{route}")),
+		leago.WithDataDragonBaseURL("http://localhost:9090/ddragon"),
+	)
+
+	for range 2 {
+		version, err := client.GetLatestVersion(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "14.1.1", version)
+	}
+
+	require.Len(t, doer.CapturedReqs, 1)
+	assert.Equal(t, "http://localhost:9090/ddragon/api/versions.json", doer.CapturedReqs[0].URL.String())
+	assert.Empty(t, doer.CapturedReqs[0].Header.Get("X-Riot-Token"))
+}
@@ -1,6 +1,9 @@
 package options
 
-import "leago/internal"
+import (
+	"leago/internal"
+	"time"
+)
 
 type (
 	// PublicOption is a safe subset of RequestOption that is exposed to the public client.
@@ -8,16 +11,81 @@ type (
 	PublicOption struct {
 		apply internal.RequestOption
 	}
+
+	// RetryPolicy controls how transient Riot errors (429 and 5xx) are retried.
+	RetryPolicy = internal.RetryPolicy
+
+	// ResponseMeta is the information about the last HTTP response received for a request.
+	ResponseMeta = internal.ResponseMeta
+
+	// RateLimitWindow is a single "count:seconds" pair sent on the rate limit headers.
+	RateLimitWindow = internal.RateLimitWindow
 )
 
 // WithApiMethod is the public wrapper to change the API method name used in the a request.
-// Identifier used to logging and, when implemented, rate limiting.
+// Identifier used to logging and method rate limiting.
 func WithApiMethod(method string) PublicOption {
 	return PublicOption{
 		apply: internal.WithApiMethod(method),
 	}
 }
 
+// WithRetryPolicy overrides the client retry policy for a single request.
+func WithRetryPolicy(policy RetryPolicy) PublicOption {
+	return PublicOption{
+		apply: internal.WithRetryPolicy(policy),
+	}
+}
+
+// WithResponseMeta fills the meta with the status, headers, rate limit counts and duration of the request.
+// The meta is also filled when the request fails with a Riot error.
+func WithResponseMeta(meta *ResponseMeta) PublicOption {
+	return PublicOption{
+		apply: internal.WithResponseMeta(meta),
+	}
+}
+
+// WithExpectedStatus logs the given error statuses at debug level instead of warn, they are still returned as errors.
+func WithExpectedStatus(statusCodes ...int) PublicOption {
+	return PublicOption{
+		apply: internal.WithExpectedStatus(statusCodes...),
+	}
+}
+
+// WithCacheBypass skips the cache lookup for the request, the fresh response is still cached.
+func WithCacheBypass() PublicOption {
+	return PublicOption{
+		apply: internal.WithCacheBypass(),
+	}
+}
+
+// WithCacheTTL overrides the cache TTL of the request, zero disables caching it.
+// Has no effect if the client has no cache.
+func WithCacheTTL(ttl time.Duration) PublicOption {
+	return PublicOption{
+		apply: internal.WithCacheTTL(ttl),
+	}
+}
+
+// WithAccessToken sends the RSO access token of the player as bearer, like the token got from the rso package.
+// The API key isn't sent on requests with an access token.
+// Responses of requests with an access token are never cached.
+func WithAccessToken(token string) PublicOption {
+	return PublicOption{
+		apply: internal.WithBearerToken(token),
+	}
+}
+
+// DefaultRetryPolicy returns the policy used when none is configured: 3 attempts with backoff from 500ms up to 10s.
+func DefaultRetryPolicy() RetryPolicy {
+	return internal.DefaultRetryPolicy()
+}
+
+// NoRetry returns a policy that never retries.
+func NoRetry() RetryPolicy {
+	return internal.NoRetry()
+}
+
 // toRequestOptions converts a slice of public options to RequestOptions.
 func toRequestOptions(opts []PublicOption) []internal.RequestOption {
 	out := make([]internal.RequestOption, len(opts))
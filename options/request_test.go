@@ -1,8 +1,12 @@
 package options_test
 
 import (
+	"context"
 	"leago/internal"
+	"leago/internal/mock"
 	"leago/options"
+	"log/slog"
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -20,3 +24,37 @@ func TestMergeOptions_Length(t *testing.T) {
 
 	require.Len(t, merged, 2)
 }
+
+func TestWithRetryPolicy(t *testing.T) {
+	merged := options.MergeOptions(
+		nil,
+		[]options.PublicOption{
+			options.WithRetryPolicy(options.NoRetry()),
+			options.WithRetryPolicy(options.DefaultRetryPolicy()),
+		},
+	)
+
+	require.Len(t, merged, 2)
+}
+
+func TestWithResponseMeta(t *testing.T) {
+	var meta options.ResponseMeta
+	merged := options.MergeOptions(nil, []options.PublicOption{options.WithResponseMeta(&meta)})
+
+	require.Len(t, merged, 1)
+}
+
+func TestWithAccessToken(t *testing.T) {
+	mockDoer := mock.NewDefaultDoer(http.StatusOK, `{}`, nil)
+	client := internal.NewHttpClient(mockDoer, slog.Default(), "americas", "apiKey")
+
+	_, err := internal.AuthRequest[map[string]any](
+		context.Background(),
+		client,
+		client.GetURL("/riot/account/v1/accounts/me"),
+		options.MergeOptions(nil, []options.PublicOption{options.WithAccessToken("accessToken")})...,
+	)
+	require.NoError(t, err)
+	require.Equal(t, "Bearer accessToken", mockDoer.CapturedReq.Header.Get("Authorization"))
+	require.Empty(t, mockDoer.CapturedReq.Header.Values("X-Riot-Token"))
+}
@@ -1,6 +1,20 @@
 package regions
 
-type Platform string
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+type (
+	Platform string
+
+	platformInfo struct {
+		displayName string
+		// aliases are the loose names accepted by ParsePlatform, besides the platform itself.
+		aliases []string
+	}
+)
 
 const (
 	PlatformBR1 Platform = "br1"
@@ -13,6 +27,7 @@ const (
 
 	PlatformEUN1 Platform = "eun1"
 	PlatformEUW1 Platform = "euw1"
+	PlatformME1  Platform = "me1"
 	PlatformTR1  Platform = "tr1"
 	PlatformRU   Platform = "ru"
 
@@ -23,3 +38,86 @@ const (
 	PlatformTW2 Platform = "tw2"
 	PlatformVN2 Platform = "vn2"
 )
+
+var (
+	ErrUnknownPlatform = errors.New("unknown platform")
+
+	platforms = []Platform{
+		PlatformBR1, PlatformLA1, PlatformLA2, PlatformNA1,
+		PlatformJP1, PlatformKR,
+		PlatformEUN1, PlatformEUW1, PlatformME1, PlatformTR1, PlatformRU,
+		PlatformOC1, PlatformPH2, PlatformSG2, PlatformTH2, PlatformTW2, PlatformVN2,
+	}
+
+	platformInfos = map[Platform]platformInfo{
+		PlatformBR1:  {displayName: "Brazil", aliases: []string{"br"}},
+		PlatformLA1:  {displayName: "Latin America North", aliases: []string{"lan"}},
+		PlatformLA2:  {displayName: "Latin America South", aliases: []string{"las"}},
+		PlatformNA1:  {displayName: "North America", aliases: []string{"na"}},
+		PlatformJP1:  {displayName: "Japan", aliases: []string{"jp"}},
+		PlatformKR:   {displayName: "Korea", aliases: []string{"kr1"}},
+		PlatformEUN1: {displayName: "Europe Nordic & East", aliases: []string{"eune", "eun"}},
+		PlatformEUW1: {displayName: "Europe West", aliases: []string{"euw"}},
+		PlatformME1:  {displayName: "Middle East", aliases: []string{"me"}},
+		PlatformTR1:  {displayName: "Türkiye", aliases: []string{"tr"}},
+		PlatformRU:   {displayName: "Russia", aliases: []string{"ru1"}},
+		PlatformOC1:  {displayName: "Oceania", aliases: []string{"oce", "oc"}},
+		PlatformPH2:  {displayName: "Philippines", aliases: []string{"ph"}},
+		PlatformSG2:  {displayName: "Singapore", aliases: []string{"sg"}},
+		PlatformTH2:  {displayName: "Thailand", aliases: []string{"th"}},
+		PlatformTW2:  {displayName: "Taiwan", aliases: []string{"tw"}},
+		PlatformVN2:  {displayName: "Vietnam", aliases: []string{"vn"}},
+	}
+
+	platformAliases = buildPlatformAliases()
+)
+
+// Platforms returns every known platform.
+func Platforms() []Platform {
+	return append([]Platform(nil), platforms...)
+}
+
+// ParsePlatform parses loose platform input, like "EUW", "euw1" or "EUW1".
+func ParsePlatform(s string) (Platform, error) {
+	if p, ok := platformAliases[normalize(s)]; ok {
+		return p, nil
+	}
+	return "", fmt.Errorf("%w: %q", ErrUnknownPlatform, s)
+}
+
+// Valid returns if the platform is a known one.
+func (p Platform) Valid() bool {
+	_, ok := platformInfos[p]
+	return ok
+}
+
+// DisplayName returns the human readable name of the platform, like "Europe West".
+// Returns the platform itself if unknown.
+func (p Platform) DisplayName() string {
+	if info, ok := platformInfos[p]; ok {
+		return info.displayName
+	}
+	return string(p)
+}
+
+func buildPlatformAliases() map[string]Platform {
+	out := make(map[string]Platform)
+	for p, info := range platformInfos {
+		out[string(p)] = p
+		for _, alias := range info.aliases {
+			out[alias] = p
+		}
+	}
+	return out
+}
+
+// normalize lowercases the input and removes separators, so "EUW 1" and "euw-1" are equivalent.
+func normalize(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '-', '_', '\t':
+			return -1
+		}
+		return r
+	}, strings.ToLower(strings.TrimSpace(s)))
+}
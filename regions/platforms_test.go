@@ -0,0 +1,94 @@
+package regions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePlatform(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    Platform
+		wantErr bool
+	}{
+		{input: "EUW", want: PlatformEUW1},
+		{input: "euw1", want: PlatformEUW1},
+		{input: "EUW1", want: PlatformEUW1},
+		{input: " eune ", want: PlatformEUN1},
+		{input: "LAN", want: PlatformLA1},
+		{input: "las", want: PlatformLA2},
+		{input: "OCE", want: PlatformOC1},
+		{input: "kr", want: PlatformKR},
+		{input: "vn-2", want: PlatformVN2},
+		{input: "", wantErr: true},
+		{input: "euw2", wantErr: true},
+		{input: "la", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParsePlatform(tt.input)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrUnknownPlatform)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseRegion(t *testing.T) {
+	got, err := ParseRegion("AMERICAS")
+	require.NoError(t, err)
+	assert.Equal(t, RegionAmericas, got)
+
+	got, err = ParseRegion("Southeast Asia")
+	require.NoError(t, err)
+	assert.Equal(t, RegionSEA, got)
+
+	_, err = ParseRegion("mars")
+	assert.ErrorIs(t, err, ErrUnknownRegion)
+}
+
+func TestParseShard(t *testing.T) {
+	got, err := ParseShard("LatAm")
+	require.NoError(t, err)
+	assert.Equal(t, ShardLATAM, got)
+
+	_, err = ParseShard("euw")
+	assert.ErrorIs(t, err, ErrUnknownShard)
+}
+
+func TestPlatformMetadata(t *testing.T) {
+	for _, p := range Platforms() {
+		assert.True(t, p.Valid(), p)
+		assert.NotEqual(t, string(p), p.DisplayName(), p)
+		assert.True(t, p.Region().Valid(), p)
+		assert.True(t, p.RegionFor(ProductLoR).Valid(), p)
+		assert.True(t, p.AccountRegion().Valid(), p)
+		assert.True(t, p.Shard().Valid(), p)
+	}
+
+	for _, r := range All() {
+		assert.True(t, r.Valid(), r)
+		assert.NotEqual(t, string(r), r.DisplayName(), r)
+	}
+
+	for _, s := range Shards() {
+		assert.True(t, s.Valid(), s)
+		assert.NotEqual(t, string(s), s.DisplayName(), s)
+	}
+
+	unknown := Platform("xx1")
+	assert.False(t, unknown.Valid())
+	assert.Equal(t, "xx1", unknown.DisplayName())
+	assert.Equal(t, "Europe West", PlatformEUW1.DisplayName())
+
+	// Returned slices are copies.
+	all := Platforms()
+	all[0] = unknown
+	assert.Equal(t, PlatformBR1, Platforms()[0])
+}
@@ -1,5 +1,10 @@
 package regions
 
+import (
+	"errors"
+	"fmt"
+)
+
 type Region string
 
 const (
@@ -8,3 +13,53 @@ const (
 	RegionEurope   Region = "europe"
 	RegionSEA      Region = "sea"
 )
+
+var (
+	ErrUnknownRegion = errors.New("unknown region")
+
+	allRegions = []Region{RegionAmericas, RegionAsia, RegionEurope, RegionSEA}
+
+	regionNames = map[Region]string{
+		RegionAmericas: "Americas",
+		RegionAsia:     "Asia",
+		RegionEurope:   "Europe",
+		RegionSEA:      "Southeast Asia",
+	}
+
+	regionAliases = map[string]Region{
+		"americas":      RegionAmericas,
+		"america":       RegionAmericas,
+		"asia":          RegionAsia,
+		"europe":        RegionEurope,
+		"sea":           RegionSEA,
+		"southeastasia": RegionSEA,
+	}
+)
+
+// All returns every regional route.
+func All() []Region {
+	return append([]Region(nil), allRegions...)
+}
+
+// ParseRegion parses loose region input, like "AMERICAS" or "Europe".
+func ParseRegion(s string) (Region, error) {
+	if r, ok := regionAliases[normalize(s)]; ok {
+		return r, nil
+	}
+	return "", fmt.Errorf("%w: %q", ErrUnknownRegion, s)
+}
+
+// Valid returns if the region is a known one.
+func (r Region) Valid() bool {
+	_, ok := regionNames[r]
+	return ok
+}
+
+// DisplayName returns the human readable name of the region.
+// Returns the region itself if unknown.
+func (r Region) DisplayName() string {
+	if name, ok := regionNames[r]; ok {
+		return name
+	}
+	return string(r)
+}
@@ -0,0 +1,144 @@
+package regions
+
+// Product is a Riot game, routing differs between them.
+type Product string
+
+const (
+	ProductLoL Product = "lol"
+	ProductTFT Product = "tft"
+	ProductLoR Product = "lor"
+	ProductVAL Product = "val"
+)
+
+var (
+	// lolRegions maps each platform to the regional route used by the LoL and TFT match APIs.
+	lolRegions = map[Platform]Region{
+		PlatformBR1: RegionAmericas,
+		PlatformLA1: RegionAmericas,
+		PlatformLA2: RegionAmericas,
+		PlatformNA1: RegionAmericas,
+
+		PlatformJP1: RegionAsia,
+		PlatformKR:  RegionAsia,
+
+		PlatformEUN1: RegionEurope,
+		PlatformEUW1: RegionEurope,
+		PlatformME1:  RegionEurope,
+		PlatformTR1:  RegionEurope,
+		PlatformRU:   RegionEurope,
+
+		PlatformOC1: RegionSEA,
+		PlatformPH2: RegionSEA,
+		PlatformSG2: RegionSEA,
+		PlatformTH2: RegionSEA,
+		PlatformTW2: RegionSEA,
+		PlatformVN2: RegionSEA,
+	}
+
+	// accountRegions maps each platform to the route of the account API, which is only served by americas, asia and europe.
+	accountRegions = map[Platform]Region{
+		PlatformBR1: RegionAmericas,
+		PlatformLA1: RegionAmericas,
+		PlatformLA2: RegionAmericas,
+		PlatformNA1: RegionAmericas,
+
+		PlatformJP1: RegionAsia,
+		PlatformKR:  RegionAsia,
+
+		PlatformEUN1: RegionEurope,
+		PlatformEUW1: RegionEurope,
+		PlatformME1:  RegionEurope,
+		PlatformTR1:  RegionEurope,
+		PlatformRU:   RegionEurope,
+
+		PlatformOC1: RegionAsia,
+		PlatformPH2: RegionAsia,
+		PlatformSG2: RegionAsia,
+		PlatformTH2: RegionAsia,
+		PlatformTW2: RegionAsia,
+		PlatformVN2: RegionAsia,
+	}
+
+	// lorRegions maps each platform to the Legends of Runeterra route, which has no asia region.
+	lorRegions = map[Platform]Region{
+		PlatformBR1: RegionAmericas,
+		PlatformLA1: RegionAmericas,
+		PlatformLA2: RegionAmericas,
+		PlatformNA1: RegionAmericas,
+
+		PlatformJP1: RegionSEA,
+		PlatformKR:  RegionSEA,
+
+		PlatformEUN1: RegionEurope,
+		PlatformEUW1: RegionEurope,
+		PlatformME1:  RegionEurope,
+		PlatformTR1:  RegionEurope,
+		PlatformRU:   RegionEurope,
+
+		PlatformOC1: RegionSEA,
+		PlatformPH2: RegionSEA,
+		PlatformSG2: RegionSEA,
+		PlatformTH2: RegionSEA,
+		PlatformTW2: RegionSEA,
+		PlatformVN2: RegionSEA,
+	}
+
+	// productRegions is the regional routing table of each product.
+	// VALORANT only uses regional routes for the account API, its game APIs are routed by Shard.
+	productRegions = map[Product]map[Platform]Region{
+		ProductLoL: lolRegions,
+		ProductTFT: lolRegions,
+		ProductLoR: lorRegions,
+		ProductVAL: accountRegions,
+	}
+
+	// valShards maps each platform to the VALORANT shard of the same area.
+	valShards = map[Platform]Shard{
+		PlatformBR1: ShardBR,
+		PlatformLA1: ShardLATAM,
+		PlatformLA2: ShardLATAM,
+		PlatformNA1: ShardNA,
+
+		PlatformJP1: ShardAP,
+		PlatformKR:  ShardKR,
+
+		PlatformEUN1: ShardEU,
+		PlatformEUW1: ShardEU,
+		PlatformME1:  ShardEU,
+		PlatformTR1:  ShardEU,
+		PlatformRU:   ShardEU,
+
+		PlatformOC1: ShardAP,
+		PlatformPH2: ShardAP,
+		PlatformSG2: ShardAP,
+		PlatformTH2: ShardAP,
+		PlatformTW2: ShardAP,
+		PlatformVN2: ShardAP,
+	}
+)
+
+// Region returns the regional route of the platform for the LoL and TFT match APIs, like euw1 -> europe or vn2 -> sea.
+// The account API has no sea route, use AccountRegion for it.
+// Returns an empty region for unknown platforms.
+func (p Platform) Region() Region {
+	return p.RegionFor(ProductLoL)
+}
+
+// AccountRegion returns the regional route of the platform for the account API, like euw1 -> europe or vn2 -> asia.
+// Any route serves every account, the closest one is returned.
+// Returns an empty region for unknown platforms.
+func (p Platform) AccountRegion() Region {
+	return accountRegions[p]
+}
+
+// RegionFor returns the regional route of the platform for the product.
+// Returns an empty region for unknown platforms or products.
+func (p Platform) RegionFor(product Product) Region {
+	return productRegions[product][p]
+}
+
+// Shard returns the VALORANT shard of the same area as the platform.
+// Returns an empty shard for unknown platforms.
+func (p Platform) Shard() Shard {
+	return valShards[p]
+}
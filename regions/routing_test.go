@@ -0,0 +1,77 @@
+package regions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlatformRegion(t *testing.T) {
+	tests := []struct {
+		platform Platform
+		want     Region
+	}{
+		{platform: PlatformBR1, want: RegionAmericas},
+		{platform: PlatformNA1, want: RegionAmericas},
+		{platform: PlatformKR, want: RegionAsia},
+		{platform: PlatformEUW1, want: RegionEurope},
+		{platform: PlatformTR1, want: RegionEurope},
+		{platform: PlatformOC1, want: RegionSEA},
+		{platform: PlatformVN2, want: RegionSEA},
+		{platform: Platform("unknown"), want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.platform), func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.platform.Region())
+		})
+	}
+}
+
+func TestPlatformRegionFor(t *testing.T) {
+	tests := []struct {
+		name     string
+		platform Platform
+		product  Product
+		want     Region
+	}{
+		{name: "tft follows lol", platform: PlatformKR, product: ProductTFT, want: RegionAsia},
+		{name: "lor has no asia", platform: PlatformKR, product: ProductLoR, want: RegionSEA},
+		{name: "lor americas", platform: PlatformNA1, product: ProductLoR, want: RegionAmericas},
+		{name: "val uses account routes", platform: PlatformVN2, product: ProductVAL, want: RegionAsia},
+		{name: "unknown product", platform: PlatformNA1, product: Product("2xko"), want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.platform.RegionFor(tt.product))
+		})
+	}
+}
+
+func TestPlatformAccountRegion(t *testing.T) {
+	tests := []struct {
+		platform Platform
+		want     Region
+	}{
+		{platform: PlatformNA1, want: RegionAmericas},
+		{platform: PlatformKR, want: RegionAsia},
+		{platform: PlatformEUW1, want: RegionEurope},
+		{platform: PlatformOC1, want: RegionAsia},
+		{platform: PlatformVN2, want: RegionAsia},
+		{platform: Platform("unknown"), want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.platform), func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.platform.AccountRegion())
+		})
+	}
+}
+
+func TestPlatformShard(t *testing.T) {
+	assert.Equal(t, ShardEU, PlatformEUW1.Shard())
+	assert.Equal(t, ShardLATAM, PlatformLA2.Shard())
+	assert.Equal(t, ShardAP, PlatformJP1.Shard())
+	assert.Equal(t, Shard(""), Platform("unknown").Shard())
+}
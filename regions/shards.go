@@ -0,0 +1,64 @@
+package regions
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Shard is the VALORANT route, used instead of the platforms by the VALORANT APIs.
+type Shard string
+
+const (
+	ShardAP      Shard = "ap"
+	ShardBR      Shard = "br"
+	ShardEU      Shard = "eu"
+	ShardKR      Shard = "kr"
+	ShardLATAM   Shard = "latam"
+	ShardNA      Shard = "na"
+	ShardEsports Shard = "esports"
+)
+
+var (
+	ErrUnknownShard = errors.New("unknown shard")
+
+	shards = []Shard{ShardAP, ShardBR, ShardEU, ShardKR, ShardLATAM, ShardNA, ShardEsports}
+
+	shardNames = map[Shard]string{
+		ShardAP:      "Asia Pacific",
+		ShardBR:      "Brazil",
+		ShardEU:      "Europe",
+		ShardKR:      "Korea",
+		ShardLATAM:   "Latin America",
+		ShardNA:      "North America",
+		ShardEsports: "Esports",
+	}
+)
+
+// Shards returns every VALORANT shard.
+func Shards() []Shard {
+	return append([]Shard(nil), shards...)
+}
+
+// ParseShard parses loose shard input, like "NA" or "LatAm".
+func ParseShard(s string) (Shard, error) {
+	shard := Shard(normalize(s))
+	if shard.Valid() {
+		return shard, nil
+	}
+	return "", fmt.Errorf("%w: %q", ErrUnknownShard, s)
+}
+
+// Valid returns if the shard is a known one.
+func (s Shard) Valid() bool {
+	_, ok := shardNames[s]
+	return ok
+}
+
+// DisplayName returns the human readable name of the shard.
+// Returns the shard itself if unknown.
+func (s Shard) DisplayName() string {
+	if name, ok := shardNames[s]; ok {
+		return name
+	}
+	return string(s)
+}
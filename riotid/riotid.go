@@ -0,0 +1,126 @@
+package riotid
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ID is the Riot ID of a player, like "Name#TAG".
+// Riot IDs are case insensitive, use Equal or Key to compare them.
+type ID struct {
+	GameName string
+	TagLine  string
+}
+
+const (
+	separator = "#"
+
+	minGameNameLength = 3
+	maxGameNameLength = 16
+	minTagLineLength  = 3
+	maxTagLineLength  = 5
+)
+
+var (
+	ErrInvalidFormat   = errors.New("riot id must be formatted as Name#TAG")
+	ErrInvalidGameName = errors.New("invalid riot id game name")
+	ErrInvalidTagLine  = errors.New("invalid riot id tag line")
+)
+
+// New returns the validated Riot ID, trimming the spaces around the game name and tag line.
+func New(gameName, tagLine string) (ID, error) {
+	id := ID{
+		GameName: strings.TrimSpace(gameName),
+		TagLine:  strings.TrimSpace(tagLine),
+	}
+
+	if err := id.Validate(); err != nil {
+		return ID{}, err
+	}
+	return id, nil
+}
+
+// Parse parses and validates a Riot ID written as "Name#TAG".
+func Parse(s string) (ID, error) {
+	gameName, tagLine, ok := strings.Cut(s, separator)
+	if !ok || strings.Contains(tagLine, separator) {
+		return ID{}, fmt.Errorf("%w: %q", ErrInvalidFormat, s)
+	}
+	return New(gameName, tagLine)
+}
+
+// Validate checks the Riot ID rules: game names have 3 to 16 letters, digits or spaces
+// and tag lines have 3 to 5 letters or digits, in any script.
+func (id ID) Validate() error {
+	if !validText(id.GameName, minGameNameLength, maxGameNameLength, true) {
+		return fmt.Errorf("%w: %q", ErrInvalidGameName, id.GameName)
+	}
+
+	if !validText(id.TagLine, minTagLineLength, maxTagLineLength, false) {
+		return fmt.Errorf("%w: %q", ErrInvalidTagLine, id.TagLine)
+	}
+	return nil
+}
+
+// String returns the Riot ID as "Name#TAG".
+func (id ID) String() string {
+	return id.GameName + separator + id.TagLine
+}
+
+// Key returns the case folded Riot ID, equal for every casing of the same ID.
+// Used as map or cache key.
+func (id ID) Key() string {
+	return fold(id.GameName) + separator + fold(id.TagLine)
+}
+
+// Equal returns if both Riot IDs are the same, ignoring the case.
+func (id ID) Equal(other ID) bool {
+	return strings.EqualFold(id.GameName, other.GameName) && strings.EqualFold(id.TagLine, other.TagLine)
+}
+
+// Path returns the game name and tag line escaped as path segments, like "Game%20Name/TAG".
+func (id ID) Path() string {
+	return url.PathEscape(id.GameName) + "/" + url.PathEscape(id.TagLine)
+}
+
+// validText checks the length in characters and that every character is a letter, a digit,
+// a combining mark (used by scripts like Thai) or, when allowed, an inner space.
+func validText(s string, minLength, maxLength int, allowSpaces bool) bool {
+	length := utf8.RuneCountInString(s)
+	if length < minLength || length > maxLength || !utf8.ValidString(s) {
+		return false
+	}
+
+	if strings.TrimSpace(s) != s {
+		return false
+	}
+
+	for _, r := range s {
+		switch {
+		case unicode.IsLetter(r), unicode.IsDigit(r), unicode.Is(unicode.Mn, r):
+		case r == ' ' && allowSpaces:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// fold maps every character to the smallest character of its case folding orbit,
+// so strings equal under strings.EqualFold have the same fold.
+func fold(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, r := range s {
+		smallest := r
+		for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+			smallest = min(smallest, f)
+		}
+		b.WriteRune(smallest)
+	}
+	return b.String()
+}
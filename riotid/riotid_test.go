@@ -0,0 +1,85 @@
+package riotid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected ID
+		wantErr  error
+	}{
+		{name: "simple", input: "Faker#KR1", expected: ID{GameName: "Faker", TagLine: "KR1"}},
+		{name: "spaces", input: " Hide on bush #KR1 ", expected: ID{GameName: "Hide on bush", TagLine: "KR1"}},
+		{name: "non ascii", input: "칼과창방패#0001", expected: ID{GameName: "칼과창방패", TagLine: "0001"}},
+		{name: "combining marks", input: "สวัสดีครับ#TH1", expected: ID{GameName: "สวัสดีครับ", TagLine: "TH1"}},
+		{name: "max length", input: "abcdefghijklmnop#abcde", expected: ID{GameName: "abcdefghijklmnop", TagLine: "abcde"}},
+		{name: "missing separator", input: "Faker", wantErr: ErrInvalidFormat},
+		{name: "multiple separators", input: "Fa#ker#KR1", wantErr: ErrInvalidFormat},
+		{name: "short game name", input: "ab#KR1", wantErr: ErrInvalidGameName},
+		{name: "long game name", input: "abcdefghijklmnopq#KR1", wantErr: ErrInvalidGameName},
+		{name: "slash on game name", input: "Fa/ker#KR1", wantErr: ErrInvalidGameName},
+		{name: "short tag line", input: "Faker#KR", wantErr: ErrInvalidTagLine},
+		{name: "long tag line", input: "Faker#KOREA1", wantErr: ErrInvalidTagLine},
+		{name: "space on tag line", input: "Faker#K R1", wantErr: ErrInvalidTagLine},
+		{name: "invalid utf8", input: "Fa\xffker#KR1", wantErr: ErrInvalidGameName},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := Parse(tt.input)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, id)
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	id, err := New(" Faker ", "KR1")
+	require.NoError(t, err)
+	assert.Equal(t, ID{GameName: "Faker", TagLine: "KR1"}, id)
+	assert.Equal(t, "Faker#KR1", id.String())
+
+	_, err = New("Fa#ker", "KR1")
+	assert.ErrorIs(t, err, ErrInvalidGameName)
+
+	assert.ErrorIs(t, ID{}.Validate(), ErrInvalidGameName)
+}
+
+func TestCaseFolding(t *testing.T) {
+	tests := []struct {
+		name  string
+		a     ID
+		b     ID
+		equal bool
+	}{
+		{name: "ascii", a: ID{GameName: "Faker", TagLine: "KR1"}, b: ID{GameName: "FAKER", TagLine: "kr1"}, equal: true},
+		{name: "greek sigma", a: ID{GameName: "ΣΟΦΟΣ", TagLine: "GR1"}, b: ID{GameName: "σοφος", TagLine: "gr1"}, equal: true},
+		{name: "final sigma", a: ID{GameName: "σοφος", TagLine: "GR1"}, b: ID{GameName: "σοφοσ", TagLine: "GR1"}, equal: true},
+		{name: "kelvin sign", a: ID{GameName: "\u212Aai", TagLine: "EUW"}, b: ID{GameName: "Kai", TagLine: "euw"}, equal: true},
+		{name: "different", a: ID{GameName: "Faker", TagLine: "KR1"}, b: ID{GameName: "Faker", TagLine: "KR2"}, equal: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.equal, tt.a.Equal(tt.b))
+			assert.Equal(t, tt.equal, tt.a.Key() == tt.b.Key())
+		})
+	}
+}
+
+func TestPath(t *testing.T) {
+	assert.Equal(t, "Hide%20on%20bush/KR1", ID{GameName: "Hide on bush", TagLine: "KR1"}.Path())
+	assert.Equal(t, "Fa%2Fker%23/KR1", ID{GameName: "Fa/ker#", TagLine: "KR1"}.Path())
+	assert.Equal(t, "%EC%B9%BC/0001", ID{GameName: "칼", TagLine: "0001"}.Path())
+}
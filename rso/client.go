@@ -0,0 +1,205 @@
+package rso
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"leago/internal"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+type (
+	// Client runs the Riot Sign-On authorization code flow of a registered RSO client.
+	Client struct {
+		clientID     string
+		clientSecret string
+		redirectURL  string
+		scopes       []string
+		authURL      string
+		tokenURL     string
+		http         internal.Doer
+		now          func() time.Time
+	}
+
+	// Option configures a Client.
+	Option func(*Client)
+
+	// Error is the OAuth2 error returned by the token endpoint, like invalid_grant for expired codes.
+	Error struct {
+		StatusCode  int
+		Code        string `json:"error"`
+		Description string `json:"error_description"`
+	}
+)
+
+const (
+	// DefaultBaseURL is the Riot Sign-On server.
+	DefaultBaseURL = "https://auth.riotgames.com"
+
+	ScopeOpenID        = "openid"
+	ScopeOfflineAccess = "offline_access"
+	ScopeCPID          = "cpid"
+
+	authorizePath = "/authorize"
+	tokenPath     = "/token"
+
+	// maxTokenBodyBytes limits how much of the token response is read.
+	maxTokenBodyBytes = 1 << 20
+)
+
+var (
+	ErrNoRefreshToken = errors.New("rso: token has no refresh token")
+
+	errNoAccessToken = errors.New("rso: token response without access_token")
+
+	defaultScopes = []string{ScopeOpenID, ScopeOfflineAccess}
+)
+
+// NewClient returns a client for the RSO client credentials, the redirectURL must match the one registered with Riot.
+// The openid and offline_access scopes are requested by default, offline_access being needed for refresh tokens.
+func NewClient(clientID, clientSecret, redirectURL string, opts ...Option) *Client {
+	c := &Client{
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		redirectURL:  redirectURL,
+		scopes:       defaultScopes,
+		authURL:      DefaultBaseURL + authorizePath,
+		tokenURL:     DefaultBaseURL + tokenPath,
+		http:         http.DefaultClient,
+		now:          time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// WithScopes overrides the scopes requested on the authorization URL and refreshes.
+func WithScopes(scopes ...string) Option {
+	return func(c *Client) {
+		c.scopes = scopes
+	}
+}
+
+// WithBaseURL overrides the RSO server, used to target local stand-in servers.
+// The authorization and token endpoints are /authorize and /token under the base URL.
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		baseURL = strings.TrimSuffix(baseURL, "/")
+		c.authURL = baseURL + authorizePath
+		c.tokenURL = baseURL + tokenPath
+	}
+}
+
+// WithHttpClient overrides the default http client used for the token requests.
+func WithHttpClient(doer internal.Doer) Option {
+	return func(c *Client) {
+		c.http = doer
+	}
+}
+
+// AuthURL returns the URL the player is redirected to for signing in.
+// The state is sent back to the redirect URL and must be checked to prevent CSRF.
+func (c *Client) AuthURL(state string) string {
+	query := url.Values{
+		"client_id":     {c.clientID},
+		"redirect_uri":  {c.redirectURL},
+		"response_type": {"code"},
+		"scope":         {strings.Join(c.scopes, " ")},
+	}
+	if state != "" {
+		query.Set("state", state)
+	}
+
+	return c.authURL + "?" + query.Encode()
+}
+
+// Exchange returns the token for the authorization code received on the redirect URL.
+func (c *Client) Exchange(ctx context.Context, code string) (Token, error) {
+	return c.requestToken(ctx, url.Values{
+		"grant_type":   {"authorization_code"},
+		"code":         {code},
+		"redirect_uri": {c.redirectURL},
+	})
+}
+
+// Refresh returns a new token for the refresh token.
+// Riot may not send a new refresh token, in that case the given one is kept.
+func (c *Client) Refresh(ctx context.Context, refreshToken string) (Token, error) {
+	if refreshToken == "" {
+		return Token{}, ErrNoRefreshToken
+	}
+
+	token, err := c.requestToken(ctx, url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {refreshToken},
+		"scope":         {strings.Join(c.scopes, " ")},
+	})
+	if err != nil {
+		return Token{}, err
+	}
+
+	if token.RefreshToken == "" {
+		token.RefreshToken = refreshToken
+	}
+	return token, nil
+}
+
+// requestToken posts the form to the token endpoint, authenticated with the client credentials.
+func (c *Client) requestToken(ctx context.Context, form url.Values) (Token, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return Token{}, err
+	}
+	req.SetBasicAuth(url.QueryEscape(c.clientID), url.QueryEscape(c.clientSecret))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return Token{}, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxTokenBodyBytes))
+	if err != nil {
+		return Token{}, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		rsoErr := &Error{StatusCode: resp.StatusCode}
+		_ = json.Unmarshal(body, rsoErr)
+		return Token{}, rsoErr
+	}
+
+	var token Token
+	if err := json.Unmarshal(body, &token); err != nil {
+		return Token{}, fmt.Errorf("rso: decoding token response: %w", err)
+	}
+
+	if token.AccessToken == "" {
+		return Token{}, errNoAccessToken
+	}
+
+	if token.ExpiresIn > 0 {
+		token.Expiry = c.now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	}
+	return token, nil
+}
+
+func (e *Error) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("rso: token request failed with status %d", e.StatusCode)
+	}
+	if e.Description == "" {
+		return fmt.Sprintf("rso: %s (status %d)", e.Code, e.StatusCode)
+	}
+	return fmt.Sprintf("rso: %s: %s (status %d)", e.Code, e.Description, e.StatusCode)
+}
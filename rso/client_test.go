@@ -0,0 +1,214 @@
+package rso
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testServer is a stand-in RSO server, issuing tokens for the code "code" and the refresh token "refresh".
+type testServer struct {
+	*httptest.Server
+	refreshes atomic.Int32
+	lastForm  url.Values
+}
+
+func newTestServer(t *testing.T) *testServer {
+	t.Helper()
+
+	ts := &testServer{}
+	ts.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != tokenPath || r.Method != http.MethodPost {
+			http.NotFound(w, r)
+			return
+		}
+
+		id, secret, ok := r.BasicAuth()
+		if !ok || id != "clientID" || secret != "clientSecret" {
+			writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_client"})
+			return
+		}
+
+		if err := r.ParseForm(); err != nil {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_request"})
+			return
+		}
+		ts.lastForm = r.PostForm
+
+		switch {
+		case r.PostForm.Get("grant_type") == "authorization_code" && r.PostForm.Get("code") == "code":
+			writeJSON(w, http.StatusOK, map[string]any{
+				"access_token":  "access",
+				"refresh_token": "refresh",
+				"id_token":      "id",
+				"token_type":    "Bearer",
+				"scope":         "openid offline_access",
+				"expires_in":    3600,
+			})
+		case r.PostForm.Get("grant_type") == "refresh_token" && r.PostForm.Get("refresh_token") == "refresh":
+			ts.refreshes.Add(1)
+			writeJSON(w, http.StatusOK, map[string]any{
+				"access_token": "refreshed",
+				"token_type":   "Bearer",
+				"expires_in":   3600,
+			})
+		default:
+			writeJSON(w, http.StatusBadRequest, map[string]string{
+				"error":             "invalid_grant",
+				"error_description": "code or refresh token is invalid",
+			})
+		}
+	}))
+	t.Cleanup(ts.Close)
+
+	return ts
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func newTestClient(ts *testServer, now time.Time, opts ...Option) *Client {
+	opts = append([]Option{WithBaseURL(ts.URL + "/"), WithHttpClient(ts.Client())}, opts...)
+	c := NewClient("clientID", "clientSecret", "http://localhost/callback", opts...)
+	c.now = func() time.Time { return now }
+	return c
+}
+
+func TestAuthURL(t *testing.T) {
+	tests := []struct {
+		name          string
+		opts          []Option
+		state         string
+		expectedBase  string
+		expectedQuery url.Values
+	}{
+		{
+			name:         "default",
+			state:        "state",
+			expectedBase: "https://auth.riotgames.com/authorize",
+			expectedQuery: url.Values{
+				"client_id":     {"clientID"},
+				"redirect_uri":  {"http://localhost/callback"},
+				"response_type": {"code"},
+				"scope":         {"openid offline_access"},
+				"state":         {"state"},
+			},
+		},
+		{
+			name:         "custom scopes and base url without state",
+			opts:         []Option{WithScopes(ScopeOpenID, ScopeCPID), WithBaseURL("http://localhost:8080")},
+			expectedBase: "http://localhost:8080/authorize",
+			expectedQuery: url.Values{
+				"client_id":     {"clientID"},
+				"redirect_uri":  {"http://localhost/callback"},
+				"response_type": {"code"},
+				"scope":         {"openid cpid"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClient("clientID", "clientSecret", "http://localhost/callback", tt.opts...)
+
+			u, err := url.Parse(c.AuthURL(tt.state))
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedBase, u.Scheme+"://"+u.Host+u.Path)
+			assert.Equal(t, tt.expectedQuery, u.Query())
+		})
+	}
+}
+
+func TestExchange(t *testing.T) {
+	ts := newTestServer(t)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		client        *Client
+		code          string
+		expectedToken Token
+		expectedErr   *Error
+	}{
+		{
+			name:   "success",
+			client: newTestClient(ts, now),
+			code:   "code",
+			expectedToken: Token{
+				AccessToken:  "access",
+				RefreshToken: "refresh",
+				IDToken:      "id",
+				TokenType:    "Bearer",
+				Scope:        "openid offline_access",
+				ExpiresIn:    3600,
+				Expiry:       now.Add(time.Hour),
+			},
+		},
+		{
+			name:        "invalid code",
+			client:      newTestClient(ts, now),
+			code:        "expired",
+			expectedErr: &Error{StatusCode: http.StatusBadRequest, Code: "invalid_grant", Description: "code or refresh token is invalid"},
+		},
+		{
+			name:        "invalid client",
+			client:      NewClient("other", "clientSecret", "http://localhost/callback", WithBaseURL(ts.URL), WithHttpClient(ts.Client())),
+			code:        "code",
+			expectedErr: &Error{StatusCode: http.StatusUnauthorized, Code: "invalid_client"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, err := tt.client.Exchange(context.Background(), tt.code)
+			if tt.expectedErr != nil {
+				var rsoErr *Error
+				require.ErrorAs(t, err, &rsoErr)
+				assert.Equal(t, tt.expectedErr, rsoErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedToken, token)
+			assert.Equal(t, "http://localhost/callback", ts.lastForm.Get("redirect_uri"))
+		})
+	}
+}
+
+func TestRefresh(t *testing.T) {
+	ts := newTestServer(t)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := newTestClient(ts, now)
+
+	token, err := c.Refresh(context.Background(), "refresh")
+	require.NoError(t, err)
+	assert.Equal(t, "refreshed", token.AccessToken)
+	assert.Equal(t, "refresh", token.RefreshToken, "keeps the refresh token when a new one isn't sent")
+	assert.Equal(t, now.Add(time.Hour), token.Expiry)
+	assert.Equal(t, "openid offline_access", ts.lastForm.Get("scope"))
+
+	_, err = c.Refresh(context.Background(), "")
+	assert.ErrorIs(t, err, ErrNoRefreshToken)
+
+	_, err = c.Refresh(context.Background(), "revoked")
+	var rsoErr *Error
+	require.ErrorAs(t, err, &rsoErr)
+	assert.Equal(t, "invalid_grant", rsoErr.Code)
+}
+
+func TestErrorMessage(t *testing.T) {
+	assert.Equal(t, "rso: token request failed with status 500", (&Error{StatusCode: 500}).Error())
+	assert.Equal(t, "rso: invalid_client (status 401)", (&Error{StatusCode: 401, Code: "invalid_client"}).Error())
+	assert.Equal(t, "rso: invalid_grant: expired (status 400)", (&Error{StatusCode: 400, Code: "invalid_grant", Description: "expired"}).Error())
+}
@@ -0,0 +1,70 @@
+package rso
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type (
+	// Token is the RSO token of a player, the access token is sent as bearer on the "me" endpoints.
+	Token struct {
+		AccessToken  string `json:"access_token"`
+		RefreshToken string `json:"refresh_token,omitempty"`
+		IDToken      string `json:"id_token,omitempty"`
+		TokenType    string `json:"token_type,omitempty"`
+		Scope        string `json:"scope,omitempty"`
+		ExpiresIn    int64  `json:"expires_in,omitempty"`
+		// Expiry is computed from ExpiresIn when the token is received, zero if it never expires.
+		Expiry time.Time `json:"expiry,omitzero"`
+	}
+
+	// TokenSource returns a valid token, refreshing it when needed.
+	TokenSource interface {
+		Token(ctx context.Context) (Token, error)
+	}
+
+	// refreshTokenSource reuses the token until it expires, then refreshes it with the client.
+	refreshTokenSource struct {
+		mu     sync.Mutex
+		client *Client
+		token  Token
+	}
+)
+
+// expiryDelta is how long before the expiry a token is refreshed, so it doesn't expire while in flight.
+const expiryDelta = 30 * time.Second
+
+// Valid returns if the token has an access token that won't expire in the next seconds.
+func (t Token) Valid(now time.Time) bool {
+	if t.AccessToken == "" {
+		return false
+	}
+	return t.Expiry.IsZero() || now.Add(expiryDelta).Before(t.Expiry)
+}
+
+// TokenSource returns a token source starting from the token, refreshed when expired.
+// It's safe for concurrent use, a single refresh is made for concurrent callers.
+func (c *Client) TokenSource(token Token) TokenSource {
+	return &refreshTokenSource{
+		client: c,
+		token:  token,
+	}
+}
+
+func (s *refreshTokenSource) Token(ctx context.Context) (Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token.Valid(s.client.now()) {
+		return s.token, nil
+	}
+
+	token, err := s.client.Refresh(ctx, s.token.RefreshToken)
+	if err != nil {
+		return Token{}, err
+	}
+
+	s.token = token
+	return token, nil
+}
@@ -0,0 +1,66 @@
+package rso
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenValid(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		token    Token
+		expected bool
+	}{
+		{name: "empty", token: Token{}, expected: false},
+		{name: "without expiry", token: Token{AccessToken: "access"}, expected: true},
+		{name: "not expired", token: Token{AccessToken: "access", Expiry: now.Add(time.Hour)}, expected: true},
+		{name: "about to expire", token: Token{AccessToken: "access", Expiry: now.Add(10 * time.Second)}, expected: false},
+		{name: "expired", token: Token{AccessToken: "access", Expiry: now.Add(-time.Hour)}, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.token.Valid(now))
+		})
+	}
+}
+
+func TestTokenSource(t *testing.T) {
+	ts := newTestServer(t)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := newTestClient(ts, now)
+
+	source := c.TokenSource(Token{AccessToken: "access", RefreshToken: "refresh", Expiry: now.Add(time.Hour)})
+	token, err := source.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "access", token.AccessToken, "valid tokens are reused")
+	assert.Zero(t, ts.refreshes.Load())
+
+	source = c.TokenSource(Token{AccessToken: "access", RefreshToken: "refresh", Expiry: now.Add(-time.Minute)})
+
+	var wg sync.WaitGroup
+	for range 5 {
+		wg.Go(func() {
+			token, err := source.Token(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, "refreshed", token.AccessToken)
+		})
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), ts.refreshes.Load(), "concurrent callers share a single refresh")
+}
+
+func TestTokenSourceWithoutRefreshToken(t *testing.T) {
+	ts := newTestServer(t)
+	c := newTestClient(ts, time.Now())
+
+	_, err := c.TokenSource(Token{AccessToken: "access", Expiry: time.Now().Add(-time.Minute)}).Token(context.Background())
+	assert.ErrorIs(t, err, ErrNoRefreshToken)
+}